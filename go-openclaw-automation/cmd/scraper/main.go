@@ -0,0 +1,410 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/database"
+	"go-openclaw-automation/internal/filter"
+	"go-openclaw-automation/internal/health"
+	"go-openclaw-automation/internal/models"
+	"go-openclaw-automation/internal/scraper"
+	_ "go-openclaw-automation/internal/scraper/ats"
+	_ "go-openclaw-automation/internal/scraper/facebook"
+	_ "go-openclaw-automation/internal/scraper/feed"
+	_ "go-openclaw-automation/internal/scraper/indeed"
+	_ "go-openclaw-automation/internal/scraper/itviec"
+	_ "go-openclaw-automation/internal/scraper/linkedin"
+	_ "go-openclaw-automation/internal/scraper/threads"
+	_ "go-openclaw-automation/internal/scraper/topcv"
+	_ "go-openclaw-automation/internal/scraper/topdev"
+	_ "go-openclaw-automation/internal/scraper/twitter"
+	_ "go-openclaw-automation/internal/scraper/vietnamworks"
+	"go-openclaw-automation/internal/telegram"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"golang.org/x/sync/errgroup"
+)
+
+func main() {
+	//--platform=topcv,itviec runs a subset of the enabled platforms (same as the Node runner)
+	platformFlag := flag.String("platform", "all", "comma-separated platforms to run (e.g. topcv,itviec)")
+	forceFlag := flag.Bool("force", false, "run platforms even while their circuit is open")
+	flag.Parse()
+
+	//load config
+	cfg := config.Load()
+	log.Printf("🔧 Config loaded. Keywords: %v", cfg.Keywords)
+	filter.Configure(cfg)
+	browser.ConfigureRateLimit(browser.RateLimitOptions{
+		RequestsPerMinute: cfg.RateLimit.RequestsPerMinute,
+		Burst:             cfg.RateLimit.Burst,
+		Jitter:            cfg.RateLimit.Jitter,
+		Recovery:          cfg.RateLimit.Recovery,
+		Sites:             cfg.RateLimit.Sites,
+	})
+
+	//resolve platforms before touching the browser so a typo fails fast
+	platforms, err := scraper.Enabled(cfg, strings.Split(*platformFlag, ","))
+	if err != nil {
+		log.Fatalf("❌ Invalid --platform: %v", err)
+	}
+	if len(platforms) == 0 {
+		log.Println("ℹ️ No platforms enabled. Nothing to do.")
+		return
+	}
+	log.Printf("🧩 Platforms: %v", platforms)
+
+	//db init
+	repo, err := database.ConnectDB(context.Background(), cfg.DatabaseURL)
+	if err != nil {
+		log.Printf("⚠️ DB not connected, jobs won't be saved: %v", err)
+	} else {
+		defer repo.Close()
+		log.Println("✅ Database Connected")
+	}
+
+	//init telegram bot
+	bot, err := telegram.NewBot(cfg.TelegramToken, cfg.TelegramChatID)
+	if err != nil {
+		log.Fatalf("❌ Failed to init Telegram Bot: %v", err)
+	}
+	log.Println("🤖 Telegram Bot initialized.")
+
+	//platforms blocked run after run are skipped for a while
+	tracker, err := health.Load(cfg.Health.StateFile, health.Options{
+		BlockedThreshold: cfg.Health.BlockedThreshold,
+		FailedThreshold:  cfg.Health.FailedThreshold,
+		Cooldown:         cfg.Health.Cooldown,
+		MaxCooldown:      cfg.Health.MaxCooldown,
+	})
+	if err != nil {
+		log.Printf("⚠️ %v. Starting with every platform healthy.", err)
+	}
+
+	//the whole run is capped by run_timeout (10 mins by default)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.RunTimeout)
+	defer cancel()
+
+	log.Println("🚀 Starting OpenClaw Automation (Go version)...")
+
+	//create scrapers first: Chromium is only launched when one of them needs it
+	scrapers := make(map[string]scraper.Scraper, len(platforms))
+	needBrowser := false
+	for _, name := range platforms {
+		if allowed, until := tracker.Allow(name); !allowed && !*forceFlag {
+			log.Printf("⏸️ Skipping %s: blocked too often, circuit open until %s (--force to run it)", name, until.Format("2006-01-02 15:04"))
+			continue
+		}
+		s, err := scraper.New(name, cfg)
+		if err != nil {
+			log.Printf("❌ Could not create scraper %s: %v", name, err)
+			continue
+		}
+		scrapers[name] = s
+		needBrowser = needBrowser || scraper.NeedsBrowser(s)
+	}
+
+	//load cookies
+	cookieFiles := map[string]string{
+		"topcv":    filepath.Join(cfg.CookiesPath, "cookies-topcv.json"),
+		"itviec":   filepath.Join(cfg.CookiesPath, "cookies-itviec.json"),
+		"linkedin": filepath.Join(cfg.CookiesPath, "cookies-linkedin.json"),
+		"twitter":  filepath.Join(cfg.CookiesPath, "cookies-twitter.json"),
+		"facebook": filepath.Join(cfg.CookiesPath, "cookies-facebook.json"),
+		"threads":  filepath.Join(cfg.CookiesPath, "cookies-threads.json"),
+	}
+	var allCookies []browser.Cookie
+	for name, cookieFile := range cookieFiles {
+		cookies, err := browser.ReadCookies(cookieFile)
+		if err != nil {
+			log.Printf("⚠️ Could not load %s cookies: %v. Continuing.", name, err)
+			continue
+		}
+		log.Printf("🍪 Loaded %s cookies (%d)", name, len(cookies))
+		allCookies = append(allCookies, cookies...)
+	}
+
+	//browserless scrapers share one cookie jar and response cache
+	httpClient := browser.NewHTTPClient(cfg.HTTPCacheTTL)
+	httpClient.AddCookies(allCookies)
+
+	//browser contexts leave through the configured proxies, a sticky one per platform with assign: platform
+	proxies, err := browser.ParseProxies(cfg.Proxy.Servers)
+	if err != nil {
+		log.Fatalf("❌ Invalid proxy config: %v", err)
+	}
+	proxyPool := browser.NewProxyPool(proxies, cfg.Proxy.Cooldown)
+	proxyPerPlatform := cfg.Proxy.Assign == "platform" && proxyPool.Len() > 0
+
+	//every platform keeps the same browser fingerprint run after run
+	fingerprints, err := browser.LoadFingerprints(cfg.Fingerprint.StateFile, cfg.Fingerprint.Platforms)
+	if err != nil {
+		if fingerprints == nil {
+			log.Fatalf("❌ Invalid fingerprint config: %v", err)
+		}
+		log.Printf("⚠️ %v. Picking new fingerprints.", err)
+	}
+
+	var pwManager *browser.PlaywrightManager
+	var pwCookies []playwright.OptionalCookie
+	if needBrowser {
+		//init playwright manager
+		pwManager, err = browser.NewPlaywright(ctx, browser.PlaywrightOptions{
+			Proxies:         proxyPool,
+			ProxyPerSession: proxyPerPlatform,
+			Fingerprints:    fingerprints,
+		})
+		if err != nil {
+			log.Fatalf("❌ Failed to init Playwright: %v", err)
+		}
+		//close playwright manager when application stops
+		defer pwManager.Close()
+
+		pwCookies = make([]playwright.OptionalCookie, len(allCookies))
+		for i, c := range allCookies {
+			pwCookies[i] = c.ToPlayWright()
+		}
+
+		if proxyPool.Len() > 0 {
+			log.Printf("🌐 Using %d proxies, assigned per %s", proxyPool.Len(), cfg.Proxy.Assign)
+		}
+
+		log.Println("✅ Browser initialized successfully!")
+	} else {
+		log.Println("🪶 No enabled scraper needs a browser, skipping Chromium.")
+	}
+
+	//every scraper opens its detail pages in one tab pool
+	details := scraper.NewDetailFetcher(cfg.MaxDetailTabs, cfg.MaxDetailTabsPerDomain)
+
+	//run scrapers concurrently, every job flows into one pipeline as soon as it is found
+	jobs := make(chan scraper.Job, 32)
+	g, gCtx := errgroup.WithContext(ctx)
+	for _, name := range platforms {
+		s, ok := scrapers[name]
+		if !ok {
+			continue
+		}
+		if ha, ok := s.(scraper.HTTPAware); ok {
+			ha.SetHTTPClient(httpClient)
+		}
+		if da, ok := s.(scraper.DetailAware); ok {
+			da.SetDetailFetcher(details)
+		}
+		//let paginating scrapers stop at the first page of jobs stored by earlier runs
+		if sa, ok := s.(scraper.SeenAware); ok && repo != nil {
+			sa.SetSeen(func(url string) bool {
+				return repo.IsJobSeen(context.Background(), url)
+			})
+		}
+		g.Go(func() error {
+			//per-platform timeout from config, bounded by the global one
+			scrapeCtx := gCtx
+			if timeout := cfg.Platform(name).Timeout; timeout > 0 {
+				var cancel context.CancelFunc
+				scrapeCtx, cancel = context.WithTimeout(gCtx, timeout)
+				defer cancel()
+			}
+
+			log.Printf("\n▶️ Starting scraper: %s", s.Name())
+
+			run := func() (int, error) {
+				var browserCtx playwright.BrowserContext
+				if pwManager != nil && scraper.NeedsBrowser(s) {
+					//own context with the platform's fingerprint and proxy
+					var err error
+					browserCtx, err = pwManager.NewSessionContext(name, pwCookies)
+					if err != nil {
+						return 0, fmt.Errorf("could not create browser context: %w", err)
+					}
+					defer browserCtx.Close()
+				}
+				return streamCounted(scrapeCtx, s, browserCtx, jobs)
+			}
+
+			n, err := run()
+			//a challenge may be about the proxy rather than the scraper: retry once on another one
+			if errors.Is(err, browser.ErrBlocked) && proxyPerPlatform && cfg.Proxy.RotateOnChallenge && pwManager != nil && scraper.NeedsBrowser(s) && pwManager.RotateProxy(name) {
+				log.Printf("🔁 Retrying %s on another proxy after %d jobs", s.Name(), n)
+				var retried int
+				retried, err = run()
+				n += retried
+			}
+			tracker.Record(name, health.OutcomeOf(err, n), err)
+
+			if err != nil {
+				//jobs already sent are kept — a timeout or late block only loses the rest
+				log.Printf("❌ Error running scraper %s after %d jobs: %v", s.Name(), n, err)
+				switch {
+				case errors.Is(err, browser.ErrLoginRequired):
+					log.Printf("🔑 %s needs a login: refresh its cookies file in %s", s.Name(), cfg.CookiesPath)
+				case errors.Is(err, browser.ErrBlocked):
+					log.Printf("🛡️ %s is blocked by an anti-bot challenge, see %s", s.Name(), challengeEvidence(err))
+				}
+				return nil
+			}
+			log.Printf("✅ Scraper %s finished. Found %d jobs.", s.Name(), n)
+			return nil
+		})
+	}
+
+	//close the pipeline once every scraper is done
+	go func() {
+		if err := g.Wait(); err != nil {
+			log.Printf("⚠️ Some scraper errors: %v", err)
+		}
+		if err := tracker.Save(); err != nil {
+			log.Printf("⚠️ %v", err)
+		}
+		if err := fingerprints.Save(); err != nil {
+			log.Printf("⚠️ %v", err)
+		}
+		close(jobs)
+	}()
+
+	//notifier drains its own queue so Telegram rate limiting never slows down the scrapers
+	notify := make(chan savedJob, 100)
+	notifyDone := make(chan struct{})
+	go func() {
+		defer close(notifyDone)
+		for result := range notify {
+			log.Printf("  [%d/10] %s @ %s", result.job.MatchScore, result.job.Title, result.job.Company)
+			if err := bot.SendJob(result.job, result.jobID); err != nil {
+				log.Printf("⚠️ Failed to send job to Telegram: %v", err)
+			}
+			time.Sleep(1 * time.Second) // rate limit: avoid Telegram 429
+		}
+	}()
+
+	// DB writes must outlive the scrape timeout, otherwise partial results are lost again
+	saveCtx := context.Background()
+	seenURLs := make(map[string]bool)
+	total, valid, sent := 0, 0, 0
+	for job := range jobs {
+		total++
+
+		//Filter job
+		if !filter.ShouldIncludeJob(job) {
+			continue
+		}
+		job.MatchScore = filter.CalculateMatchScore(job)
+
+		// Dedup within this run, then against the DB as the single source of truth.
+		// When repo is nil (no DB), treat ALL jobs as unseen (send everything).
+		if seenURLs[job.URL] {
+			continue
+		}
+		seenURLs[job.URL] = true
+		//unique valid jobs of this run, whether or not an earlier run already sent them
+		valid++
+		if repo != nil && repo.IsJobSeen(saveCtx, job.URL) {
+			continue
+		}
+
+		notify <- savedJob{job: job, jobID: saveJob(saveCtx, repo, job)}
+		sent++
+	}
+	close(notify)
+	<-notifyDone
+
+	for _, alert := range tracker.Alerts() {
+		log.Printf("⚠️ Platform health alert: %s", alert)
+		if err := bot.SendStatus("⚠️ Platform health alert: " + alert); err != nil {
+			log.Printf("⚠️ Failed to send status to Telegram: %v", err)
+		}
+	}
+
+	log.Printf("\n📦 Total jobs collected: %d (valid: %d, new: %d)", total, valid, sent)
+
+	if sent > 0 {
+		// Send summary status
+		statusMsg := fmt.Sprintf("✅ Found %d valid jobs, sent %d new jobs.", valid, sent)
+		if err := bot.SendStatus(statusMsg); err != nil {
+			log.Printf("⚠️ Failed to send status to Telegram: %v", err)
+		}
+	}
+
+	log.Println("🏁 Execution finished.")
+}
+
+type savedJob struct {
+	job   scraper.Job
+	jobID string // empty if repo is nil or save failed
+}
+
+// saveJob persists a job and returns its DB ID (empty when there is no DB or the save fails)
+func saveJob(ctx context.Context, repo *database.Repository, j scraper.Job) string {
+	if repo == nil {
+		return ""
+	}
+	dbJob := &models.Job{
+		Source:         j.Source,
+		ExternalID:     extractExternalID(j.URL),
+		Title:          j.Title,
+		Company:        j.Company,
+		URL:            j.URL,
+		Location:       j.Location,
+		Salary:         j.Salary,
+		DescriptionRaw: j.Description,
+		MatchScore:     j.MatchScore,
+		PostedAt:       j.PostedDate,
+	}
+	salary := scraper.ParseSalary(j.Salary)
+	if salary.Min > 0 {
+		dbJob.SalaryMin = &salary.Min
+	}
+	if salary.Max > 0 {
+		dbJob.SalaryMax = &salary.Max
+	}
+	dbJob.SalaryCurrency = salary.Currency
+	dbJob.SalaryPeriod = salary.Period
+	dbJob.SalaryNegotiable = salary.Negotiable
+
+	saved, err := repo.SaveJob(ctx, dbJob)
+	if err != nil {
+		log.Printf("⚠️ Failed to save job to DB: %v", err)
+		return ""
+	}
+	log.Printf("💾 Job saved to DB with ID: %s", saved.ID)
+	return saved.ID
+}
+
+// streamCounted runs s, forwarding its jobs to the shared pipeline, and returns how many it sent
+func streamCounted(ctx context.Context, s scraper.Scraper, browserCtx playwright.BrowserContext, jobs chan<- scraper.Job) (int, error) {
+	found := make(chan scraper.Job)
+	count := make(chan int)
+	go func() {
+		n := 0
+		for job := range found {
+			n++
+			jobs <- job
+		}
+		count <- n
+	}()
+
+	err := scraper.Stream(ctx, s, browserCtx, found)
+	close(found)
+	return <-count, err
+}
+
+// challengeEvidence is where the check that failed saved the blocked page
+func challengeEvidence(err error) string {
+	var challenge *browser.ChallengeError
+	if errors.As(err, &challenge) && challenge.Evidence != "" {
+		return challenge.Evidence
+	}
+	return "the logs"
+}
+
+// extractExternalID returns the key IsJobSeen looks up: the job URL itself
+func extractExternalID(url string) string {
+	return strings.TrimSpace(url)
+}
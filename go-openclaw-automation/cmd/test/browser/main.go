@@ -15,7 +15,7 @@ func main(){
 	ctx := context.Background()
 
 	//create playwright manager
-	pm, err := browser.NewPlaywright(ctx)
+	pm, err := browser.NewPlaywright(ctx, browser.PlaywrightOptions{})
 	if err != nil {
 		log.Fatalf("Failed to create Playwright: %v", err)
 	}
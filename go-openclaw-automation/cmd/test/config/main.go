@@ -11,6 +11,7 @@ func main() {
 	fmt.Printf("   Telegram Chat ID: %d\n", cfg.TelegramChatID)
 	fmt.Printf("   Keywords: %v\n", cfg.Keywords)
 	fmt.Printf("   Locations: %v\n", cfg.Locations)
+	fmt.Printf("   Location Tiers: primary %v, secondary %v\n", cfg.LocationTiers.Primary, cfg.LocationTiers.Secondary)
 	fmt.Printf("   Facebook Groups: %d groups\n", len(cfg.FacebookGroups))
 	fmt.Printf("   Cookies Path: %s\n", cfg.CookiesPath)
 }
\ No newline at end of file
@@ -0,0 +1,89 @@
+// cmd/validate_selectors/main.go
+// Checks a saved page against a platform's selector pack and reports selectors that no longer match.
+// Usage: go run ./cmd/validate_selectors/ -platform topcv -page saved-search.html [-only search.,card.]
+// Exits 1 when any selector is MISSING, so it can gate a pack update in CI.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go-openclaw-automation/internal/scraper/selectors"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+func main() {
+	platform := flag.String("platform", "", "platform pack to check (topcv, itviec, linkedin, ...)")
+	pagePath := flag.String("page", "", "saved HTML page to check against")
+	dir := flag.String("dir", "configs/selectors", "directory with selector pack overrides")
+	only := flag.String("only", "", "comma-separated name prefixes to check (e.g. search.,card.)")
+	flag.Parse()
+
+	if *platform == "" || *pagePath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	html, err := os.ReadFile(*pagePath)
+	if err != nil {
+		log.Fatalf("❌ Could not read %s: %v", *pagePath, err)
+	}
+
+	pack := selectors.ForPlatform(*dir, *platform)
+	log.Printf("📐 %s selector pack v%s", pack.Platform, pack.Version)
+
+	//headless browser just to evaluate CSS on the saved page
+	pw, err := playwright.Run()
+	if err != nil {
+		log.Fatalf("❌ Could not start playwright: %v", err)
+	}
+	defer pw.Stop()
+
+	browser, err := pw.Chromium.Launch(playwright.BrowserTypeLaunchOptions{Headless: playwright.Bool(true)})
+	if err != nil {
+		log.Fatalf("❌ Could not launch browser: %v", err)
+	}
+	defer browser.Close()
+
+	page, err := browser.NewPage(playwright.BrowserNewPageOptions{JavaScriptEnabled: playwright.Bool(false)})
+	if err != nil {
+		log.Fatalf("❌ Could not open page: %v", err)
+	}
+	if err := page.SetContent(string(html)); err != nil {
+		log.Fatalf("❌ Could not load saved page: %v", err)
+	}
+
+	var prefixes []string
+	if *only != "" {
+		prefixes = strings.Split(*only, ",")
+	}
+	results, err := selectors.Validate(page, pack, prefixes...)
+	if err != nil {
+		log.Fatalf("❌ Validation failed: %v", err)
+	}
+
+	missing := 0
+	for _, r := range results {
+		icon := "✅"
+		switch r.Status {
+		case selectors.StatusFallback:
+			icon = "⚠️"
+		case selectors.StatusMissing:
+			icon = "❌"
+			missing++
+		}
+		fmt.Printf("%s %-9s %-28s %v", icon, r.Status, r.Name, r.Counts)
+		if r.Matched != "" {
+			fmt.Printf("  → %s", r.Matched)
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("\n%d selectors checked, %d missing\n", len(results), missing)
+	if missing > 0 {
+		os.Exit(1)
+	}
+}
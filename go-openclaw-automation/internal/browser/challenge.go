@@ -0,0 +1,267 @@
+// Anti-bot challenge detection
+// Classifies a loaded page as a Cloudflare interstitial, Turnstile, CAPTCHA, login wall,
+// rate-limit page or OK; waits out interstitials that clear on their own and saves a
+// screenshot and the HTML of pages that stay blocked
+
+package browser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// Typed errors of a failed check, test them with errors.Is
+var (
+	//ErrBlocked: an anti-bot page (Cloudflare, CAPTCHA, rate limit) did not go away
+	ErrBlocked = errors.New("blocked by anti-bot challenge")
+	//ErrLoginRequired: the site wants a login, cookies are missing or expired
+	ErrLoginRequired = errors.New("login required")
+)
+
+// Challenge is what a page turned out to be
+type Challenge string
+
+const (
+	ChallengeNone       Challenge = "ok"
+	ChallengeCloudflare Challenge = "cloudflare"
+	ChallengeTurnstile  Challenge = "turnstile"
+	ChallengeCaptcha    Challenge = "captcha" //reCAPTCHA, hCaptcha or the site's own
+	ChallengeLoginWall  Challenge = "login wall"
+	ChallengeRateLimit  Challenge = "rate limit"
+)
+
+// clearsOnItsOwn reports the interstitials worth waiting for; every other challenge fails at once
+func (c Challenge) clearsOnItsOwn() bool {
+	return c == ChallengeCloudflare || c == ChallengeTurnstile
+}
+
+// ChallengeError is returned by Check for a page that stays blocked.
+// It unwraps to ErrLoginRequired for a login wall and to ErrBlocked otherwise.
+type ChallengeError struct {
+	Platform string
+	Kind     Challenge
+	URL      string
+	Evidence string //screenshot path, empty when it could not be saved
+}
+
+func (e *ChallengeError) Error() string {
+	return fmt.Sprintf("%s: %s at %s", e.Platform, e.Kind, e.URL)
+}
+
+func (e *ChallengeError) Unwrap() error {
+	if e.Kind == ChallengeLoginWall {
+		return ErrLoginRequired
+	}
+	return ErrBlocked
+}
+
+// ChallengeSignals is what Classify looks at, read from the page by Detect
+type ChallengeSignals struct {
+	URL    string
+	Title  string
+	Text   string   //start of the visible body text
+	Status int      //HTTP status of the navigation, 0 when unknown
+	Frames []string //URL and name of every frame
+	//the platform's own login form / captcha selectors matched
+	LoginForm bool
+	Captcha   bool
+}
+
+var (
+	loginURLRegex  = regexp.MustCompile(`(?i)/(login|signin|sign-in|uas/login|authwall|checkpoint|accounts/login)\b`)
+	rateLimitRegex = regexp.MustCompile(`(?i)(too many requests|rate limited|rate limit exceeded|error 1015|qua nhieu yeu cau|quá nhiều yêu cầu)`)
+	//Cloudflare's interstitial and block page titles, in English and Vietnamese
+	cloudflareTitleRegex = regexp.MustCompile(`(?i)(just a moment|attention required|checking your browser|security check|cloudflare|chờ một chút|một chút thôi)`)
+	cloudflareTextRegex  = regexp.MustCompile(`(?i)(verify you are human|checking if the site connection is secure|enable javascript and cookies to continue|performance & security by cloudflare|xác minh bạn là con người)`)
+	turnstileFrameRegex  = regexp.MustCompile(`(?i)(challenges\.cloudflare\.com|turnstile)`)
+	//visible reCAPTCHA/hCaptcha widgets; invisible reCAPTCHA v3 is on plenty of normal pages
+	captchaFrameRegex = regexp.MustCompile(`(?i)(/recaptcha/|hcaptcha\.com)`)
+	captchaTextRegex  = regexp.MustCompile(`(?i)(unusual traffic|i'm not a robot|i am not a robot|tôi không phải là người máy)`)
+)
+
+// Classify tells what kind of page the signals describe. A login wall wins over a
+// rate limit, which wins over a CAPTCHA, Turnstile and a plain Cloudflare interstitial.
+func Classify(s ChallengeSignals) Challenge {
+	if s.LoginForm || loginURLRegex.MatchString(s.URL) {
+		return ChallengeLoginWall
+	}
+	if s.Status == 429 || rateLimitRegex.MatchString(s.Title) || rateLimitRegex.MatchString(s.Text) {
+		return ChallengeRateLimit
+	}
+	if s.Captcha || captchaTextRegex.MatchString(s.Text) {
+		return ChallengeCaptcha
+	}
+	for _, frame := range s.Frames {
+		if captchaFrameRegex.MatchString(frame) && !strings.Contains(frame, "size=invisible") {
+			return ChallengeCaptcha
+		}
+	}
+
+	//a Turnstile widget on a normal page (a login form) is no challenge
+	if !cloudflareTitleRegex.MatchString(s.Title) && !cloudflareTextRegex.MatchString(s.Text) {
+		return ChallengeNone
+	}
+	for _, frame := range s.Frames {
+		if turnstileFrameRegex.MatchString(frame) {
+			return ChallengeTurnstile
+		}
+	}
+	return ChallengeCloudflare
+}
+
+// ChallengeOptions tunes a ChallengeDetector; zero values take the defaults
+type ChallengeOptions struct {
+	//Wait is how long a Cloudflare/Turnstile interstitial may take to clear (default 15s)
+	Wait time.Duration
+	//Interval is how often the page is checked again while waiting (default 2s)
+	Interval time.Duration
+	//EvidenceDir receives a screenshot and the HTML of pages that stay blocked (default logs/challenges)
+	EvidenceDir string
+	//The platform's login form and captcha widget (optional)
+	LoginSelector   string
+	CaptchaSelector string
+	//TurnstileCheckbox is clicked inside the Turnstile frame (default input[type="checkbox"])
+	TurnstileCheckbox string
+}
+
+// ChallengeDetector checks the pages of one platform. Safe for concurrent use.
+type ChallengeDetector struct {
+	platform string
+	opts     ChallengeOptions
+}
+
+// NewChallengeDetector creates the detector of a platform (the name is used in errors and evidence files)
+func NewChallengeDetector(platform string, opts ChallengeOptions) *ChallengeDetector {
+	if opts.Wait <= 0 {
+		opts.Wait = 15 * time.Second
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = 2 * time.Second
+	}
+	if opts.EvidenceDir == "" {
+		opts.EvidenceDir = filepath.Join("logs", "challenges")
+	}
+	if opts.TurnstileCheckbox == "" {
+		opts.TurnstileCheckbox = `input[type="checkbox"]`
+	}
+	return &ChallengeDetector{platform: platform, opts: opts}
+}
+
+// Detect classifies the page as it is now; status is the navigation's HTTP status (0 = unknown)
+func (d *ChallengeDetector) Detect(page playwright.Page, status int) Challenge {
+	signals := ChallengeSignals{URL: page.URL(), Status: status}
+	signals.Title, _ = page.Title()
+	if text, err := page.Evaluate(`() => document.body ? document.body.innerText.slice(0, 3000) : ""`); err == nil {
+		signals.Text, _ = text.(string)
+	}
+	for _, frame := range page.Frames() {
+		signals.Frames = append(signals.Frames, frame.URL()+" "+frame.Name())
+	}
+	if d.opts.LoginSelector != "" {
+		count, _ := page.Locator(d.opts.LoginSelector).Count()
+		signals.LoginForm = count > 0
+	}
+	if d.opts.CaptchaSelector != "" {
+		count, _ := page.Locator(d.opts.CaptchaSelector).Count()
+		signals.Captcha = count > 0
+	}
+	return Classify(signals)
+}
+
+// Check returns nil when the page just loaded (resp may be nil) is usable. A Cloudflare or
+// Turnstile interstitial is rechecked until it clears or Wait runs out, clicking the Turnstile
+// checkbox once. A page that stays blocked is saved as evidence and returned as a *ChallengeError.
+func (d *ChallengeDetector) Check(ctx context.Context, page playwright.Page, resp playwright.Response) error {
+	status := 0
+	if resp != nil {
+		status = resp.Status()
+	}
+	kind := d.Detect(page, status)
+	if kind == ChallengeNone {
+		return nil
+	}
+	//even a challenge that clears means the site noticed us
+	navigation.Backoff(page.URL(), string(kind)+" challenge", 0)
+
+	if kind.clearsOnItsOwn() {
+		log.Printf("    🛡️ %s: %s challenge detected, waiting up to %v...", d.platform, kind, d.opts.Wait)
+		clicked := false
+		deadline := time.Now().Add(d.opts.Wait)
+		for kind.clearsOnItsOwn() && time.Now().Before(deadline) {
+			if kind == ChallengeTurnstile && !clicked {
+				clicked = d.clickTurnstile(page)
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(d.opts.Interval):
+			}
+			//the status belongs to the challenge page, not to whatever replaced it
+			kind = d.Detect(page, 0)
+		}
+		if kind == ChallengeNone {
+			log.Printf("    ✅ %s: challenge passed", d.platform)
+			return nil
+		}
+	}
+
+	err := &ChallengeError{Platform: d.platform, Kind: kind, URL: page.URL()}
+	err.Evidence = d.saveEvidence(page, kind)
+	log.Printf("    🚫 %v", err)
+	return err
+}
+
+// clickTurnstile clicks the checkbox of the Turnstile frame, reporting whether it did
+func (d *ChallengeDetector) clickTurnstile(page playwright.Page) bool {
+	for _, frame := range page.Frames() {
+		if !turnstileFrameRegex.MatchString(frame.URL() + " " + frame.Name()) {
+			continue
+		}
+		checkbox := frame.Locator(d.opts.TurnstileCheckbox).First()
+		if visible, _ := checkbox.IsVisible(); !visible {
+			continue
+		}
+		MouseJiggle(page)
+		if err := checkbox.Click(); err != nil {
+			log.Printf("    ⚠️ %s: could not click the Turnstile checkbox: %v", d.platform, err)
+			return false
+		}
+		log.Printf("    🖱️ %s: clicked the Turnstile checkbox", d.platform)
+		return true
+	}
+	return false
+}
+
+// saveEvidence writes a screenshot and the HTML of the page, returning the screenshot path
+func (d *ChallengeDetector) saveEvidence(page playwright.Page, kind Challenge) string {
+	if err := os.MkdirAll(d.opts.EvidenceDir, 0755); err != nil {
+		log.Printf("⚠️ Failed to create evidence dir: %v", err)
+		return ""
+	}
+	name := fmt.Sprintf("%s-%s_%s", d.platform, strings.ReplaceAll(string(kind), " ", "-"), time.Now().Format("2006-01-02_15-04-05"))
+	base := filepath.Join(d.opts.EvidenceDir, strings.ToLower(name))
+
+	if html, err := page.Content(); err == nil {
+		if err := os.WriteFile(base+".html", []byte(html), 0644); err != nil {
+			log.Printf("⚠️ Failed to save challenge HTML: %v", err)
+		}
+	}
+	if _, err := page.Screenshot(playwright.PageScreenshotOptions{
+		Path:     playwright.String(base + ".png"),
+		FullPage: playwright.Bool(true),
+	}); err != nil {
+		log.Printf("⚠️ Failed to capture screenshot: %v", err)
+		return ""
+	}
+	log.Printf("📸 %s: %s evidence saved: %s", d.platform, kind, base+".png")
+	return base + ".png"
+}
@@ -0,0 +1,62 @@
+package browser
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name    string
+		signals ChallengeSignals
+		want    Challenge
+	}{
+		{"job page", ChallengeSignals{URL: "https://www.topcv.vn/viec-lam/golang", Title: "Tuyển Golang Developer", Text: "Mô tả công việc"}, ChallengeNone},
+		{"cloudflare interstitial", ChallengeSignals{Title: "Just a moment...", Text: "Checking if the site connection is secure"}, ChallengeCloudflare},
+		{"cloudflare block page", ChallengeSignals{Title: "Attention Required! | Cloudflare", Status: 403}, ChallengeCloudflare},
+		{"cloudflare in vietnamese", ChallengeSignals{Title: "Chờ một chút..."}, ChallengeCloudflare},
+		{"indeed security check", ChallengeSignals{Title: "Security Check - Indeed.com"}, ChallengeCloudflare},
+		{"turnstile", ChallengeSignals{
+			Title:  "Just a moment...",
+			Frames: []string{"https://itviec.com/ ", "https://challenges.cloudflare.com/cdn-cgi/challenge-platform/h/b/turnstile/if/ov2 cf-chl-widget-x"},
+		}, ChallengeTurnstile},
+		{"turnstile widget on a normal page", ChallengeSignals{
+			Title:  "Sign in | ITviec",
+			Frames: []string{"https://challenges.cloudflare.com/turnstile/if/ov2 "},
+		}, ChallengeNone},
+		{"recaptcha", ChallengeSignals{Title: "TopCV", Frames: []string{"https://www.google.com/recaptcha/api2/anchor?k=abc&size=normal "}}, ChallengeCaptcha},
+		{"invisible recaptcha", ChallengeSignals{Title: "TopCV", Frames: []string{"https://www.google.com/recaptcha/api2/anchor?k=abc&size=invisible "}}, ChallengeNone},
+		{"site captcha selector", ChallengeSignals{Title: "TopCV", Captcha: true}, ChallengeCaptcha},
+		{"google sorry page", ChallengeSignals{Text: "Our systems have detected unusual traffic from your computer network."}, ChallengeCaptcha},
+		{"linkedin authwall", ChallengeSignals{URL: "https://www.linkedin.com/authwall?trk=qf"}, ChallengeLoginWall},
+		{"facebook checkpoint", ChallengeSignals{URL: "https://www.facebook.com/checkpoint/1501092823525282/"}, ChallengeLoginWall},
+		{"login form selector", ChallengeSignals{URL: "https://x.com/search?q=golang", LoginForm: true}, ChallengeLoginWall},
+		{"status 429", ChallengeSignals{Title: "Việc làm Golang", Status: 429}, ChallengeRateLimit},
+		{"cloudflare rate limit", ChallengeSignals{Title: "Access denied | itviec.com used Cloudflare to restrict access", Text: "Error 1015 You are being rate limited"}, ChallengeRateLimit},
+		{"too many requests", ChallengeSignals{Title: "429 Too Many Requests"}, ChallengeRateLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Classify(tt.signals))
+		})
+	}
+}
+
+func TestChallengeError_Is(t *testing.T) {
+	blocked := fmt.Errorf("topcv: search failed: %w", &ChallengeError{Platform: "topcv", Kind: ChallengeTurnstile, URL: "https://www.topcv.vn/"})
+	assert.ErrorIs(t, blocked, ErrBlocked)
+	assert.NotErrorIs(t, blocked, ErrLoginRequired)
+
+	login := &ChallengeError{Platform: "linkedin", Kind: ChallengeLoginWall, URL: "https://www.linkedin.com/authwall"}
+	assert.ErrorIs(t, login, ErrLoginRequired)
+	assert.NotErrorIs(t, login, ErrBlocked)
+	assert.Equal(t, "linkedin: login wall at https://www.linkedin.com/authwall", login.Error())
+
+	var challenge *ChallengeError
+	assert.True(t, errors.As(blocked, &challenge))
+	assert.Equal(t, ChallengeTurnstile, challenge.Kind)
+}
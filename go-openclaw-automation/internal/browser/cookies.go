@@ -2,7 +2,9 @@ package browser
 
 import (
 	"encoding/json"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/playwright-community/playwright-go"
 )
@@ -20,16 +22,11 @@ type Cookie struct{
 }
 
 func LoadCookies(path string) ([]playwright.OptionalCookie, error){
-	data, err := os.ReadFile(path)
+	cookies, err := ReadCookies(path)
 	if err != nil{
 		return nil, err
 	}
 
-	var cookies []Cookie
-	if err := json.Unmarshal(data, &cookies); err != nil{
-		return nil, err
-	}
-
 	pwCookies := make([]playwright.OptionalCookie, len(cookies))
 	for i, c := range cookies{
 		pwCookies[i] = c.ToPlayWright()
@@ -37,6 +34,20 @@ func LoadCookies(path string) ([]playwright.OptionalCookie, error){
 	return pwCookies, nil
 }
 
+// ReadCookies reads the raw cookie JSON, shared by browser contexts and the HTTP client
+func ReadCookies(path string) ([]Cookie, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cookies []Cookie
+	if err := json.Unmarshal(data, &cookies); err != nil {
+		return nil, err
+	}
+	return cookies, nil
+}
+
 func (c Cookie) ToPlayWright() playwright.OptionalCookie {
 	pwCookie := playwright.OptionalCookie{
 		Name: c.Name,
@@ -68,4 +79,32 @@ func (c Cookie) ToPlayWright() playwright.OptionalCookie {
 	}
 
 	return pwCookie
-}
\ No newline at end of file
+}
+
+// ToHTTP converts the cookie for net/http; session cookies (expires <= 0) get no expiry
+func (c Cookie) ToHTTP() *http.Cookie {
+	cookie := &http.Cookie{
+		Name:     c.Name,
+		Value:    c.Value,
+		Domain:   c.Domain,
+		Path:     c.Path,
+		HttpOnly: c.HTTPOnly,
+		Secure:   c.Secure,
+	}
+	if cookie.Path == "" {
+		cookie.Path = "/"
+	}
+	if c.Expires > 0 {
+		cookie.Expires = time.Unix(int64(c.Expires), 0)
+	}
+
+	switch c.SameSite {
+	case "Lax":
+		cookie.SameSite = http.SameSiteLaxMode
+	case "Strict":
+		cookie.SameSite = http.SameSiteStrictMode
+	case "None", "no_restriction":
+		cookie.SameSite = http.SameSiteNoneMode
+	}
+	return cookie
+}
@@ -0,0 +1,393 @@
+// Browser fingerprint profiles
+// A profile is a consistent bundle of what a site can read about the browser: UA, platform,
+// screen, locale, languages and GPU. Every platform keeps the profile it got on its first
+// run (in a state file), so a site sees the same "device" run after run. An init script
+// patches the usual automation tells: navigator.webdriver, empty plugins and the WebGL vendor.
+// The UA and the client hints name the Chrome version the launched Chromium reports.
+
+package browser
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-openclaw-automation/utils"
+	"math/rand"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// Fingerprint is one device profile, every field has to agree with the others
+type Fingerprint struct {
+	Name              string   `json:"name"`
+	UserAgent         string   `json:"user_agent"`
+	Platform          string   `json:"platform"` //navigator.platform, matching the UA's OS
+	Width             int      `json:"width"`    //viewport
+	Height            int      `json:"height"`
+	ScreenWidth       int      `json:"screen_width"`
+	ScreenHeight      int      `json:"screen_height"`
+	DeviceScaleFactor float64  `json:"device_scale_factor"`
+	Locale            string   `json:"locale"`
+	TimezoneID        string   `json:"timezone_id"`
+	AcceptLanguage    string   `json:"accept_language"`
+	Languages         []string `json:"languages"` //navigator.languages, same order as AcceptLanguage
+	//navigator.hardwareConcurrency and navigator.deviceMemory (GB)
+	HardwareConcurrency int `json:"hardware_concurrency"`
+	DeviceMemory        int `json:"device_memory"`
+	//UNMASKED_VENDOR_WEBGL / UNMASKED_RENDERER_WEBGL, headless Chromium reports SwiftShader
+	WebGLVendor   string `json:"webgl_vendor"`
+	WebGLRenderer string `json:"webgl_renderer"`
+	//client hints (navigator.userAgentData, Sec-CH-UA): the full version of the Chrome the UA
+	//names, and the OS, its version and the CPU architecture behind it
+	ChromeVersion     string `json:"chrome_version"`
+	UAPlatform        string `json:"ua_platform"`
+	UAPlatformVersion string `json:"ua_platform_version"`
+	UAArchitecture    string `json:"ua_architecture"`
+}
+
+// Vietnamese users with English as a second language, like the searches
+const (
+	vietnameseLocale         = "vi-VN"
+	vietnameseTimezone       = "Asia/Ho_Chi_Minh"
+	vietnameseAcceptLanguage = "vi-VN,vi;q=0.9,en-US;q=0.8,en;q=0.7"
+)
+
+var vietnameseLanguages = []string{"vi-VN", "vi", "en-US", "en"}
+
+// BundledChromeVersion is the Chromium of the pinned playwright-go (v0.5200.1, Playwright 1.52).
+// The profiles and HTTPUserAgent name it; NewPlaywright moves the profiles to the launched version.
+const BundledChromeVersion = "136.0.7103.25"
+
+// chromeVersionRegex is the version in a Chrome UA, which only carries the major ("Chrome/136.0.0.0")
+var chromeVersionRegex = regexp.MustCompile(`Chrome/[\d.]+`)
+
+// Fingerprints are the built-in profiles, all Chrome BundledChromeVersion like HTTPUserAgent
+var Fingerprints = []Fingerprint{
+	{
+		Name:                "windows-laptop",
+		UserAgent:           HTTPUserAgent,
+		Platform:            "Win32",
+		Width:               1366,
+		Height:              768,
+		ScreenWidth:         1366,
+		ScreenHeight:        768,
+		DeviceScaleFactor:   1,
+		Locale:              vietnameseLocale,
+		TimezoneID:          vietnameseTimezone,
+		AcceptLanguage:      vietnameseAcceptLanguage,
+		Languages:           vietnameseLanguages,
+		HardwareConcurrency: 8,
+		DeviceMemory:        8,
+		WebGLVendor:         "Google Inc. (Intel)",
+		WebGLRenderer:       "ANGLE (Intel, Intel(R) UHD Graphics 620 Direct3D11 vs_5_0 ps_5_0, D3D11)",
+		ChromeVersion:       BundledChromeVersion,
+		UAPlatform:          "Windows",
+		UAPlatformVersion:   "10.0.0",
+		UAArchitecture:      "x86",
+	},
+	{
+		Name:                "windows-desktop",
+		UserAgent:           HTTPUserAgent,
+		Platform:            "Win32",
+		Width:               1920,
+		Height:              969,
+		ScreenWidth:         1920,
+		ScreenHeight:        1080,
+		DeviceScaleFactor:   1,
+		Locale:              vietnameseLocale,
+		TimezoneID:          vietnameseTimezone,
+		AcceptLanguage:      vietnameseAcceptLanguage,
+		Languages:           vietnameseLanguages,
+		HardwareConcurrency: 12,
+		DeviceMemory:        8,
+		WebGLVendor:         "Google Inc. (NVIDIA)",
+		WebGLRenderer:       "ANGLE (NVIDIA, NVIDIA GeForce GTX 1660 SUPER Direct3D11 vs_5_0 ps_5_0, D3D11)",
+		ChromeVersion:       BundledChromeVersion,
+		UAPlatform:          "Windows",
+		UAPlatformVersion:   "10.0.0",
+		UAArchitecture:      "x86",
+	},
+	{
+		Name:                "macbook",
+		UserAgent:           "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/136.0.0.0 Safari/537.36",
+		Platform:            "MacIntel",
+		Width:               1440,
+		Height:              789,
+		ScreenWidth:         1440,
+		ScreenHeight:        900,
+		DeviceScaleFactor:   2,
+		Locale:              vietnameseLocale,
+		TimezoneID:          vietnameseTimezone,
+		AcceptLanguage:      vietnameseAcceptLanguage,
+		Languages:           vietnameseLanguages,
+		HardwareConcurrency: 8,
+		DeviceMemory:        8,
+		WebGLVendor:         "Google Inc. (Apple)",
+		WebGLRenderer:       "ANGLE (Apple, Apple M1, OpenGL 4.1)",
+		ChromeVersion:       BundledChromeVersion,
+		UAPlatform:          "macOS", //the UA is frozen at 10_15_7, the client hints are not
+		UAPlatformVersion:   "14.5.0",
+		UAArchitecture:      "arm",
+	},
+}
+
+// DefaultFingerprint is used without a store
+var DefaultFingerprint = Fingerprints[0]
+
+// FindFingerprint returns the built-in profile called name
+func FindFingerprint(name string) (Fingerprint, bool) {
+	for _, fp := range Fingerprints {
+		if fp.Name == name {
+			return fp, true
+		}
+	}
+	return Fingerprint{}, false
+}
+
+// withChromeVersion is the profile as the Chrome of version ("136.0.7103.25", from
+// Browser.Version()); a version without a numeric major leaves the profile as it is
+func (fp Fingerprint) withChromeVersion(version string) Fingerprint {
+	major, _, _ := strings.Cut(version, ".")
+	if _, err := strconv.Atoi(major); err != nil {
+		return fp
+	}
+	fp.ChromeVersion = version
+	fp.UserAgent = chromeVersionRegex.ReplaceAllString(fp.UserAgent, "Chrome/"+major+".0.0.0")
+	return fp
+}
+
+// clientHintHeaders are the low-entropy Sec-CH-UA headers Chrome sends with every request.
+// The brands match navigator.userAgentData.brands in stealthScript.
+func (fp Fingerprint) clientHintHeaders() map[string]string {
+	major, _, _ := strings.Cut(fp.ChromeVersion, ".")
+	return map[string]string{
+		"Sec-CH-UA":          fmt.Sprintf(`"Chromium";v="%s", "Google Chrome";v="%s", "Not.A/Brand";v="99"`, major, major),
+		"Sec-CH-UA-Mobile":   "?0",
+		"Sec-CH-UA-Platform": strconv.Quote(fp.UAPlatform),
+	}
+}
+
+// contextOptions are the browser context settings of the profile
+func (fp Fingerprint) contextOptions() playwright.BrowserNewContextOptions {
+	headers := fp.clientHintHeaders()
+	headers["Accept-Language"] = fp.AcceptLanguage
+	return playwright.BrowserNewContextOptions{
+		UserAgent:         playwright.String(fp.UserAgent),
+		Viewport:          &playwright.Size{Width: fp.Width, Height: fp.Height},
+		Screen:            &playwright.Size{Width: fp.ScreenWidth, Height: fp.ScreenHeight},
+		DeviceScaleFactor: playwright.Float(fp.DeviceScaleFactor),
+		Locale:            playwright.String(fp.Locale),
+		TimezoneId:        playwright.String(fp.TimezoneID),
+		ExtraHttpHeaders:  headers,
+		JavaScriptEnabled: playwright.Bool(true),
+	}
+}
+
+// stealthScript patches navigator and WebGL before any page script runs; __FINGERPRINT__ is
+// replaced by the profile as JSON
+const stealthScript = `(() => {
+	const fp = __FINGERPRINT__;
+	const getter = (obj, prop, value) => {
+		try {
+			Object.defineProperty(obj, prop, { get: () => value, configurable: true });
+		} catch (e) {}
+	};
+
+	//automation flag, false like a regular Chrome
+	getter(Navigator.prototype, 'webdriver', false);
+	getter(Navigator.prototype, 'platform', fp.platform);
+	getter(Navigator.prototype, 'languages', Object.freeze([...fp.languages]));
+	getter(Navigator.prototype, 'language', fp.languages[0]);
+	getter(Navigator.prototype, 'hardwareConcurrency', fp.hardware_concurrency);
+	getter(Navigator.prototype, 'deviceMemory', fp.device_memory);
+
+	//client hints: Chromium reports its own brand and the host OS, Chrome the profile's
+	if (window.NavigatorUAData) {
+		const major = fp.chrome_version.split('.')[0];
+		const brands = [
+			{ brand: 'Chromium', version: major },
+			{ brand: 'Google Chrome', version: major },
+			{ brand: 'Not.A/Brand', version: '99' },
+		];
+		const values = {
+			architecture: fp.ua_architecture,
+			bitness: '64',
+			model: '',
+			platformVersion: fp.ua_platform_version,
+			uaFullVersion: fp.chrome_version,
+			fullVersionList: brands.map((b) => ({ brand: b.brand, version: b.brand === 'Not.A/Brand' ? '99.0.0.0' : fp.chrome_version })),
+			wow64: false,
+		};
+		const uaData = Object.create(NavigatorUAData.prototype);
+		getter(uaData, 'brands', Object.freeze(brands));
+		getter(uaData, 'mobile', false);
+		getter(uaData, 'platform', fp.ua_platform);
+		uaData.toJSON = () => ({ brands, mobile: false, platform: fp.ua_platform });
+		uaData.getHighEntropyValues = (hints) => {
+			const result = { brands, mobile: false, platform: fp.ua_platform };
+			(hints || []).forEach((hint) => {
+				if (hint in values) result[hint] = values[hint];
+			});
+			return Promise.resolve(result);
+		};
+		getter(Navigator.prototype, 'userAgentData', uaData);
+	}
+
+	//Chrome's built-in PDF viewer plugins, headless has none
+	if (navigator.plugins.length === 0) {
+		const names = ['PDF Viewer', 'Chrome PDF Viewer', 'Chromium PDF Viewer', 'Microsoft Edge PDF Viewer', 'WebKit built-in PDF'];
+		const mimeTypes = [];
+		const plugins = names.map((name) => {
+			const plugin = Object.create(Plugin.prototype);
+			getter(plugin, 'name', name);
+			getter(plugin, 'filename', 'internal-pdf-viewer');
+			getter(plugin, 'description', 'Portable Document Format');
+			['application/pdf', 'text/pdf'].forEach((type, i) => {
+				const mime = Object.create(MimeType.prototype);
+				getter(mime, 'type', type);
+				getter(mime, 'suffixes', 'pdf');
+				getter(mime, 'description', 'Portable Document Format');
+				getter(mime, 'enabledPlugin', plugin);
+				getter(plugin, i, mime);
+				if (mimeTypes.length < 2) mimeTypes.push(mime);
+			});
+			getter(plugin, 'length', 2);
+			return plugin;
+		});
+		const list = (items, proto, key) => {
+			const arr = Object.create(proto);
+			items.forEach((item, i) => getter(arr, i, item));
+			getter(arr, 'length', items.length);
+			arr.item = (i) => items[i] || null;
+			arr.namedItem = (name) => items.find((item) => item[key] === name) || null;
+			arr[Symbol.iterator] = function* () { yield* items; };
+			return arr;
+		};
+		getter(Navigator.prototype, 'plugins', list(plugins, PluginArray.prototype, 'name'));
+		getter(Navigator.prototype, 'mimeTypes', list(mimeTypes, MimeTypeArray.prototype, 'type'));
+	}
+
+	//the window is launched at a fixed size, make it wrap the profile's viewport with Chrome's toolbars
+	getter(window, 'outerWidth', fp.width);
+	getter(window, 'outerHeight', fp.height + 85);
+
+	//window.chrome is missing in headless
+	if (!window.chrome) {
+		window.chrome = { runtime: {}, app: { isInstalled: false }, csi: () => {}, loadTimes: () => {} };
+	}
+
+	//headless answers "denied" to notifications while Notification.permission says "default"
+	if (navigator.permissions && navigator.permissions.query) {
+		const query = navigator.permissions.query.bind(navigator.permissions);
+		navigator.permissions.query = (params) =>
+			params && params.name === 'notifications'
+				? Promise.resolve({ state: Notification.permission, onchange: null })
+				: query(params);
+	}
+
+	//UNMASKED_VENDOR_WEBGL / UNMASKED_RENDERER_WEBGL
+	for (const ctx of [window.WebGLRenderingContext, window.WebGL2RenderingContext]) {
+		if (!ctx) continue;
+		const getParameter = ctx.prototype.getParameter;
+		ctx.prototype.getParameter = function (param) {
+			if (param === 37445) return fp.webgl_vendor;
+			if (param === 37446) return fp.webgl_renderer;
+			return getParameter.call(this, param);
+		};
+	}
+})();`
+
+// initScript is the stealth script of the profile
+func (fp Fingerprint) initScript() string {
+	data, _ := json.Marshal(fp)
+	return strings.Replace(stealthScript, "__FINGERPRINT__", string(data), 1)
+}
+
+// FingerprintStore remembers the profile of every platform across runs. Safe for concurrent use.
+type FingerprintStore struct {
+	path   string
+	pinned map[string]string //platform -> profile name from config
+	pick   func(n int) int
+
+	mu       sync.Mutex
+	sessions map[string]string //platform -> profile name
+}
+
+// LoadFingerprints reads the assignments from path (a missing file starts empty).
+// pinned forces a profile on a platform ("topcv": "macbook").
+func LoadFingerprints(path string, pinned map[string]string) (*FingerprintStore, error) {
+	for platform, name := range pinned {
+		if _, ok := FindFingerprint(name); !ok {
+			return nil, fmt.Errorf("unknown fingerprint %q for %s, one of %s", name, platform, strings.Join(fingerprintNames(), ", "))
+		}
+	}
+
+	s := &FingerprintStore{path: path, pinned: pinned, pick: rand.Intn, sessions: map[string]string{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return s, fmt.Errorf("failed to read fingerprints: %w", err)
+	}
+	if err := json.Unmarshal(data, &s.sessions); err != nil {
+		s.sessions = map[string]string{}
+		return s, fmt.Errorf("failed to parse fingerprints %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// For returns the platform's profile: the pinned one, the one it had before, or a random one
+// it keeps from now on. A nil store returns DefaultFingerprint.
+func (s *FingerprintStore) For(platform string) Fingerprint {
+	if s == nil {
+		return DefaultFingerprint
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if name, ok := s.pinned[platform]; ok {
+		s.sessions[platform] = name
+	}
+	if fp, ok := FindFingerprint(s.sessions[platform]); ok {
+		return fp
+	}
+	//first run, or the profile is no longer built in
+	fp := Fingerprints[s.pick(len(Fingerprints))]
+	s.sessions[platform] = fp.Name
+	return fp
+}
+
+// Save writes the platform assignments to the state file
+func (s *FingerprintStore) Save() error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	data, err := json.MarshalIndent(s.sessions, "", "  ")
+	s.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode fingerprints: %w", err)
+	}
+
+	if err := utils.WriteFileAtomic(s.path, data); err != nil {
+		return fmt.Errorf("failed to save fingerprints: %w", err)
+	}
+	return nil
+}
+
+// fingerprintNames lists the built-in profiles, sorted
+func fingerprintNames() []string {
+	names := make([]string, len(Fingerprints))
+	for i, fp := range Fingerprints {
+		names[i] = fp.Name
+	}
+	sort.Strings(names)
+	return names
+}
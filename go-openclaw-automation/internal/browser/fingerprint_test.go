@@ -0,0 +1,173 @@
+package browser
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprints_AreConsistent(t *testing.T) {
+	names := map[string]bool{}
+	for _, fp := range Fingerprints {
+		t.Run(fp.Name, func(t *testing.T) {
+			assert.False(t, names[fp.Name], "duplicate name")
+			names[fp.Name] = true
+
+			assert.True(t, strings.HasPrefix(fp.UserAgent, "Mozilla/5.0 ("), "well-formed UA: %s", fp.UserAgent)
+			major, _, _ := strings.Cut(fp.ChromeVersion, ".")
+			assert.Contains(t, fp.UserAgent, "Chrome/"+major+".0.0.0", "UA and client hints name the same Chrome")
+			switch fp.Platform {
+			case "Win32":
+				assert.Contains(t, fp.UserAgent, "(Windows NT 10.0; Win64; x64)")
+				assert.NotContains(t, fp.WebGLRenderer, "Apple")
+				assert.Equal(t, "Windows", fp.UAPlatform)
+			case "MacIntel":
+				assert.Contains(t, fp.UserAgent, "(Macintosh; Intel Mac OS X")
+				assert.Equal(t, "macOS", fp.UAPlatform)
+			default:
+				t.Errorf("unexpected platform %q", fp.Platform)
+			}
+
+			assert.LessOrEqual(t, fp.Width, fp.ScreenWidth)
+			assert.LessOrEqual(t, fp.Height, fp.ScreenHeight)
+			assert.Positive(t, fp.DeviceScaleFactor)
+			require.NotEmpty(t, fp.Languages)
+			assert.Equal(t, fp.Locale, fp.Languages[0])
+			assert.True(t, strings.HasPrefix(fp.AcceptLanguage, fp.Locale+","))
+			assert.NotEmpty(t, fp.WebGLVendor)
+		})
+	}
+	assert.Equal(t, HTTPUserAgent, DefaultFingerprint.UserAgent, "the HTTP client and the default context look alike")
+}
+
+func TestFingerprint_WithChromeVersion(t *testing.T) {
+	fp, ok := FindFingerprint("macbook")
+	require.True(t, ok)
+
+	launched := fp.withChromeVersion("140.0.7339.16")
+	assert.Equal(t, "140.0.7339.16", launched.ChromeVersion)
+	assert.Equal(t, "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/140.0.0.0 Safari/537.36", launched.UserAgent)
+	assert.Equal(t, map[string]string{
+		"Sec-CH-UA":          `"Chromium";v="140", "Google Chrome";v="140", "Not.A/Brand";v="99"`,
+		"Sec-CH-UA-Mobile":   "?0",
+		"Sec-CH-UA-Platform": `"macOS"`,
+	}, launched.clientHintHeaders())
+	builtIn, _ := FindFingerprint("macbook")
+	assert.Equal(t, fp, builtIn, "the built-in profile is unchanged")
+
+	assert.Equal(t, fp, fp.withChromeVersion(""), "no version keeps the bundled one")
+	assert.Equal(t, fp, fp.withChromeVersion("unknown"))
+}
+
+func TestFingerprint_InitScript(t *testing.T) {
+	fp, ok := FindFingerprint("macbook")
+	require.True(t, ok)
+	script := fp.initScript()
+
+	assert.NotContains(t, script, "__FINGERPRINT__")
+	data, _ := json.Marshal(fp)
+	assert.Contains(t, script, "const fp = "+string(data)+";")
+}
+
+func newTestStore(t *testing.T, path string, pinned map[string]string) *FingerprintStore {
+	t.Helper()
+	s, err := LoadFingerprints(path, pinned)
+	require.NoError(t, err)
+	next := 0
+	s.pick = func(n int) int {
+		next++
+		return next % n
+	}
+	return s
+}
+
+func TestFingerprintStore_KeepsProfilesAcrossRuns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "fingerprints.json")
+
+	s := newTestStore(t, path, nil)
+	topcv := s.For("topcv")
+	itviec := s.For("itviec")
+	assert.NotEqual(t, topcv.Name, itviec.Name)
+	assert.Equal(t, topcv, s.For("topcv"), "sticky within a run")
+	require.NoError(t, s.Save())
+
+	//next run: same profiles whatever the random pick says
+	s = newTestStore(t, path, nil)
+	s.pick = func(int) int { return 2 }
+	assert.Equal(t, topcv.Name, s.For("topcv").Name)
+	assert.Equal(t, itviec.Name, s.For("itviec").Name)
+}
+
+func TestFingerprintStore_PinnedAndUnknownProfiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fingerprints.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"topcv": "windows-laptop", "itviec": "retired-profile"}`), 0644))
+
+	s := newTestStore(t, path, map[string]string{"topcv": "macbook"})
+	assert.Equal(t, "macbook", s.For("topcv").Name, "config pin wins over the state file")
+	itviec := s.For("itviec")
+	_, ok := FindFingerprint(itviec.Name)
+	assert.True(t, ok, "a profile that is no longer built in is replaced")
+
+	_, err := LoadFingerprints(path, map[string]string{"topcv": "iphone"})
+	assert.ErrorContains(t, err, "unknown fingerprint")
+
+	var none *FingerprintStore
+	assert.Equal(t, DefaultFingerprint, none.For("topcv"))
+	assert.NoError(t, none.Save())
+}
+
+func TestFingerprintStore_CorruptStateFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fingerprints.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{not json`), 0644))
+
+	s, err := LoadFingerprints(path, nil)
+	assert.Error(t, err)
+	require.NotNil(t, s, "usable with fresh assignments")
+	assert.NotEmpty(t, s.For("topcv").Name)
+}
+
+func TestPlaywrightManager_ContextFingerprint(t *testing.T) {
+	store := newTestStore(t, filepath.Join(t.TempDir(), "fingerprints.json"), map[string]string{"linkedin": "macbook"})
+	pm := newTestManager(t, nil)
+	pm.fingerprints = store
+
+	browserCtx, err := pm.NewSessionContext("linkedin", nil)
+	require.NoError(t, err)
+	defer browserCtx.Close()
+	page, err := browserCtx.NewPage()
+	require.NoError(t, err)
+	require.NoError(t, page.SetContent(`<html><body>ok</body></html>`))
+
+	got, err := page.Evaluate(`() => {
+		const gl = document.createElement('canvas').getContext('webgl');
+		return {
+			webdriver: navigator.webdriver,
+			platform: navigator.platform,
+			userAgent: navigator.userAgent,
+			language: navigator.language,
+			plugins: navigator.plugins.length,
+			width: window.innerWidth,
+			scale: window.devicePixelRatio,
+			vendor: gl ? gl.getParameter(37445) : null,
+		};
+	}`)
+	require.NoError(t, err)
+	props := got.(map[string]any)
+
+	macbook, _ := FindFingerprint("macbook")
+	assert.Equal(t, false, props["webdriver"])
+	assert.Equal(t, "MacIntel", props["platform"])
+	assert.Equal(t, macbook.UserAgent, props["userAgent"])
+	assert.Equal(t, "vi-VN", props["language"])
+	assert.EqualValues(t, 5, props["plugins"])
+	assert.EqualValues(t, macbook.Width, props["width"])
+	assert.EqualValues(t, 2, props["scale"])
+	if props["vendor"] != nil {
+		assert.Equal(t, macbook.WebGLVendor, props["vendor"])
+	}
+}
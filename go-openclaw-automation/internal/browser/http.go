@@ -0,0 +1,209 @@
+// Browserless HTTP fetcher
+// For plain-HTML sites without bot protection: no Chromium, the same cookie JSON
+// as the browser contexts, and a per-run response cache shared by every scraper
+
+package browser
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// HTTPUserAgent is sent by the HTTP client with DefaultFingerprint's client hints, matching
+// the Chrome the browser contexts pretend to be (BundledChromeVersion)
+const HTTPUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/136.0.0.0 Safari/537.36"
+
+// maxBodyBytes caps a single response, a longer body is an error rather than a truncated document
+const maxBodyBytes = 10 << 20
+
+// HTTPError is returned for non-200 responses so callers can react to 403/429
+type HTTPError struct {
+	Method     string //GET when empty
+	URL        string
+	StatusCode int
+}
+
+func (e *HTTPError) Error() string {
+	method := e.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	return fmt.Sprintf("%s %s: unexpected status %d", method, e.URL, e.StatusCode)
+}
+
+type cachedResponse struct {
+	body    []byte
+	fetched time.Time
+}
+
+// HTTPClient fetches pages over plain HTTP. Safe for concurrent use.
+type HTTPClient struct {
+	client   *http.Client
+	cacheTTL time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cachedResponse
+}
+
+// NewHTTPClient creates a client with an empty cookie jar.
+// Successful responses are cached for cacheTTL (0 disables caching).
+func NewHTTPClient(cacheTTL time.Duration) *HTTPClient {
+	jar, _ := cookiejar.New(nil) //never fails with nil options
+	return &HTTPClient{
+		client: &http.Client{
+			Jar:     jar,
+			Timeout: 30 * time.Second,
+		},
+		cacheTTL: cacheTTL,
+		cache:    make(map[string]cachedResponse),
+	}
+}
+
+// AddCookies puts cookies exported for the browser (cookies-*.json) into the jar
+func (c *HTTPClient) AddCookies(cookies []Cookie) {
+	byHost := make(map[string][]*http.Cookie)
+	for _, cookie := range cookies {
+		host := strings.TrimPrefix(cookie.Domain, ".")
+		if host == "" {
+			continue
+		}
+		byHost[host] = append(byHost[host], cookie.ToHTTP())
+	}
+	for host, hostCookies := range byHost {
+		c.client.Jar.SetCookies(&url.URL{Scheme: "https", Host: host, Path: "/"}, hostCookies)
+	}
+}
+
+// LoadCookieFiles adds every readable cookie file to the jar, missing files are logged and skipped
+func (c *HTTPClient) LoadCookieFiles(paths ...string) {
+	for _, path := range paths {
+		cookies, err := ReadCookies(path)
+		if err != nil {
+			log.Printf("⚠️ Could not load HTTP cookies from %s: %v", path, err)
+			continue
+		}
+		c.AddCookies(cookies)
+	}
+}
+
+// Get returns the body of url, from the cache when a fresh copy exists.
+// Only 200 responses are cached; other statuses return *HTTPError.
+func (c *HTTPClient) Get(ctx context.Context, rawURL string) ([]byte, error) {
+	if body, ok := c.cached(rawURL); ok {
+		return body, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,application/json;q=0.9,*/*;q=0.8")
+
+	body, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.cacheTTL > 0 {
+		c.mu.Lock()
+		c.cache[rawURL] = cachedResponse{body: body, fetched: time.Now()}
+		c.mu.Unlock()
+	}
+	return body, nil
+}
+
+// PostJSON sends payload as a JSON body and returns the response body.
+// Search APIs answer POST requests with fresh results, so nothing is cached.
+func (c *HTTPClient) PostJSON(ctx context.Context, rawURL string, payload any) ([]byte, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode request for %s: %w", rawURL, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rawURL, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	return c.do(req)
+}
+
+// do sends req with the browser-like headers and reads a 200 body
+func (c *HTTPClient) do(req *http.Request) ([]byte, error) {
+	req.Header.Set("User-Agent", HTTPUserAgent)
+	for name, value := range DefaultFingerprint.clientHintHeaders() {
+		req.Header.Set(name, value)
+	}
+	req.Header.Set("Accept-Language", "vi-VN,vi;q=0.9,en-US;q=0.8,en;q=0.7")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	rawURL := req.URL.String()
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPError{Method: req.Method, URL: rawURL, StatusCode: resp.StatusCode}
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", rawURL, err)
+	}
+	if len(body) > maxBodyBytes {
+		return nil, fmt.Errorf("response of %s is larger than %d bytes", rawURL, maxBodyBytes)
+	}
+	return body, nil
+}
+
+// Document fetches url and parses it as HTML
+func (c *HTTPClient) Document(ctx context.Context, rawURL string) (*goquery.Document, error) {
+	body, err := c.Get(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := ParseHTML(body)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", rawURL, err)
+	}
+	doc.Url, _ = url.Parse(rawURL)
+	return doc, nil
+}
+
+// ParseHTML parses an HTML body so scrapers can query it with the same CSS selectors
+// they use on a Playwright page
+func ParseHTML(body []byte) (*goquery.Document, error) {
+	return goquery.NewDocumentFromReader(bytes.NewReader(body))
+}
+
+func (c *HTTPClient) cached(rawURL string) ([]byte, bool) {
+	if c.cacheTTL <= 0 {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.cache[rawURL]
+	if !ok {
+		return nil, false
+	}
+	if time.Since(entry.fetched) > c.cacheTTL {
+		delete(c.cache, rawURL)
+		return nil, false
+	}
+	return entry.body, true
+}
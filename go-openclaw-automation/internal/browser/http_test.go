@@ -0,0 +1,139 @@
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPClient_CookiesFromJSON(t *testing.T) {
+	var gotSession string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if c, err := r.Cookie("session"); err == nil {
+			gotSession = c.Value
+		}
+		w.Write([]byte("<html></html>"))
+	}))
+	defer server.Close()
+
+	//same format as the cookies-*.json files loaded into the browser context
+	path := filepath.Join(t.TempDir(), "cookies-test.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[
+		{"name": "session", "value": "abc123", "domain": "127.0.0.1", "path": "/", "expires": -1, "httpOnly": true, "secure": true, "sameSite": "Lax"},
+		{"name": "expired", "value": "old", "domain": "127.0.0.1", "path": "/", "expires": 1000}
+	]`), 0644))
+
+	client := NewHTTPClient(0)
+	client.client.Transport = server.Client().Transport
+	client.LoadCookieFiles(path, filepath.Join(t.TempDir(), "missing.json"))
+
+	_, err := client.Get(context.Background(), server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", gotSession)
+}
+
+func TestHTTPClient_ClientHints(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Write([]byte("<html></html>"))
+	}))
+	defer server.Close()
+
+	_, err := NewHTTPClient(0).Get(context.Background(), server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, HTTPUserAgent, got.Get("User-Agent"))
+	assert.Equal(t, `"Chromium";v="136", "Google Chrome";v="136", "Not.A/Brand";v="99"`, got.Get("Sec-CH-UA"))
+	assert.Equal(t, `"Windows"`, got.Get("Sec-CH-UA-Platform"))
+	assert.Equal(t, "?0", got.Get("Sec-CH-UA-Mobile"))
+}
+
+func TestHTTPClient_Cache(t *testing.T) {
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Write([]byte(`<html><body><h1 class="title">Golang Developer</h1></body></html>`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(time.Minute)
+	for i := 0; i < 3; i++ {
+		doc, err := client.Document(context.Background(), server.URL)
+		require.NoError(t, err)
+		assert.Equal(t, "Golang Developer", doc.Find("h1.title").Text())
+	}
+	assert.Equal(t, 1, hits)
+
+	//expired entries are fetched again
+	client.mu.Lock()
+	entry := client.cache[server.URL]
+	entry.fetched = time.Now().Add(-2 * time.Minute)
+	client.cache[server.URL] = entry
+	client.mu.Unlock()
+
+	_, err := client.Get(context.Background(), server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, 2, hits)
+}
+
+func TestHTTPClient_StatusError(t *testing.T) {
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(time.Minute)
+	for i := 0; i < 2; i++ {
+		_, err := client.Get(context.Background(), server.URL)
+
+		var httpErr *HTTPError
+		require.True(t, errors.As(err, &httpErr))
+		assert.Equal(t, http.StatusTooManyRequests, httpErr.StatusCode)
+	}
+	//errors are never cached
+	assert.Equal(t, 2, hits)
+}
+
+func TestHTTPClient_BodyTooLarge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(make([]byte, maxBodyBytes+1))
+	}))
+	defer server.Close()
+
+	_, err := NewHTTPClient(time.Minute).Get(context.Background(), server.URL)
+	assert.ErrorContains(t, err, "larger than")
+}
+
+func TestHTTPClient_PostJSON(t *testing.T) {
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		var payload map[string]any
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" || json.NewDecoder(r.Body).Decode(&payload) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"query":"` + payload["query"].(string) + `"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(time.Minute)
+	for i := 0; i < 2; i++ {
+		body, err := client.PostJSON(context.Background(), server.URL, map[string]any{"query": "golang"})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"query":"golang"}`, string(body))
+	}
+	//search results are never served from the cache
+	assert.Equal(t, 2, hits)
+}
@@ -4,16 +4,35 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync"
 
 	"github.com/playwright-community/playwright-go"
 )
 
+// PlaywrightOptions are what the contexts of a PlaywrightManager get; zero values take the defaults
+type PlaywrightOptions struct {
+	//Proxies every context leaves through (nil = direct connection)
+	Proxies *ProxyPool
+	//ProxyPerSession gives every session its own sticky proxy; otherwise all of them share one
+	ProxyPerSession bool
+	//Fingerprints are the profiles of the sessions (nil = DefaultFingerprint for all)
+	Fingerprints *FingerprintStore
+}
+
 type PlaywrightManager struct {
-	pw      *playwright.Playwright
-	browser playwright.Browser
+	pw            *playwright.Playwright
+	browser       playwright.Browser
+	chromeVersion string //what the launched Chromium reports, the profiles claim the same
+	proxies       *ProxyPool
+	perSession    bool
+	fingerprints  *FingerprintStore
+
+	mu      sync.Mutex
+	bridges map[Proxy]*socksBridge //local bridges of authenticated SOCKS5 proxies, by proxy (password included)
 }
 
-func NewPlaywright(ctx context.Context) (*PlaywrightManager, error) {
+// NewPlaywright launches the browser
+func NewPlaywright(ctx context.Context, opts PlaywrightOptions) (*PlaywrightManager, error) {
 	//Install playwright
 	err := playwright.Install()
 	if err != nil {
@@ -49,29 +68,52 @@ func NewPlaywright(ctx context.Context) (*PlaywrightManager, error) {
 		return nil, fmt.Errorf("could not launch browser: %w", err)
 	}
 
+	//a UA naming another Chrome than navigator.userAgentData and Sec-CH-UA is a bot tell
+	version := browser.Version()
+	if version != BundledChromeVersion {
+		log.Printf("🌐 Chromium %s (profiles built for %s), using the launched version", version, BundledChromeVersion)
+	}
+
 	return &PlaywrightManager{
-		pw:      pw,
-		browser: browser,
+		pw:            pw,
+		browser:       browser,
+		chromeVersion: version,
+		proxies:       opts.Proxies,
+		perSession:    opts.ProxyPerSession,
+		fingerprints:  opts.Fingerprints,
+		bridges:       map[Proxy]*socksBridge{},
 	}, nil
 }
 
 // NewContext creates a browser context with human-like settings (UserAgent explained in LEARNING-04.md)
 func (pm *PlaywrightManager) NewContext(cookies []playwright.OptionalCookie) (playwright.BrowserContext, error) {
+	return pm.NewSessionContext("default", cookies)
+}
+
+// NewSessionContext creates a context like NewContext with the session's fingerprint profile
+// (sessions are platforms), leaving through the session's sticky proxy
+func (pm *PlaywrightManager) NewSessionContext(session string, cookies []playwright.OptionalCookie) (playwright.BrowserContext, error) {
+	proxy, err := pm.sessionProxy(session)
+	if err != nil {
+		return nil, err
+	}
+
 	//create context with stealth settings
-	ctx, err := pm.browser.NewContext(playwright.BrowserNewContextOptions{
-		UserAgent: playwright.String("Mozilla/5.0(Windows NT 10.0; Win64; x64 AppleWebKit/537.36) (KHTML, like Gecko) Chrome/121.0.0.0 Safari/537.36"),
-		Viewport: &playwright.Size{
-			Width:  1280,
-			Height: 800,
-		},
-		Locale:            playwright.String("vi-VN"),
-		TimezoneId:        playwright.String("Asia/Ho_Chi_Minh"),
-		JavaScriptEnabled: playwright.Bool(true),
-	})
+	fp := pm.fingerprints.For(session).withChromeVersion(pm.chromeVersion)
+	opts := fp.contextOptions()
+	opts.Proxy = proxy
+	ctx, err := pm.browser.NewContext(opts)
 	if err != nil {
 		return nil, err
 	}
 
+	//patch the automation tells before any page script runs
+	if err := ctx.AddInitScript(playwright.Script{Content: playwright.String(fp.initScript())}); err != nil {
+		ctx.Close()
+		return nil, fmt.Errorf("could not add stealth script: %w", err)
+	}
+	log.Printf("🎭 %s browses as %s", session, fp.Name)
+
 	//add cookies
 	if len(cookies) > 0 {
 		err = ctx.AddCookies(cookies)
@@ -83,7 +125,58 @@ func (pm *PlaywrightManager) NewContext(cookies []playwright.OptionalCookie) (pl
 	return ctx, nil
 }
 
+// RotateProxy moves the session to another proxy, for contexts created after it; false when
+// there is no other proxy. Without ProxyPerSession every session moves.
+func (pm *PlaywrightManager) RotateProxy(session string) bool {
+	session = pm.proxySession(session)
+	from, _ := pm.proxies.Session(session)
+	to, ok := pm.proxies.Rotate(session)
+	if ok {
+		log.Printf("🔀 Proxy for %s: %s -> %s", session, from, to)
+	}
+	return ok
+}
+
+// proxySession is the pool session the contexts of session leave through
+func (pm *PlaywrightManager) proxySession(session string) string {
+	if pm.perSession {
+		return session
+	}
+	return "default"
+}
+
+// sessionProxy is the context proxy of the session, nil without a pool
+func (pm *PlaywrightManager) sessionProxy(session string) (*playwright.Proxy, error) {
+	p, ok := pm.proxies.Session(pm.proxySession(session))
+	if !ok {
+		return nil, nil
+	}
+	if p.Scheme != "socks5" || p.Username == "" {
+		return playwrightProxy(p), nil
+	}
+
+	//Chromium does not authenticate to SOCKS5, go through a local bridge that does
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	bridge, ok := pm.bridges[p]
+	if !ok {
+		var err error
+		bridge, err = startSOCKSBridge(p)
+		if err != nil {
+			return nil, err
+		}
+		pm.bridges[p] = bridge
+	}
+	return playwrightProxy(bridge.Proxy()), nil
+}
+
 func (pm *PlaywrightManager) Close() error {
+	pm.mu.Lock()
+	for _, bridge := range pm.bridges {
+		bridge.Close()
+	}
+	pm.mu.Unlock()
+
 	if pm.browser != nil {
 		if err := pm.browser.Close(); err != nil {
 			log.Printf("Error closing browser: %v", err)
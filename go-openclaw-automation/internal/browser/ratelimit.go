@@ -0,0 +1,224 @@
+// Per-domain rate limiting
+// Every page load waits for a token of its site's bucket, plus a random jitter. A site that
+// pushes back (429, 403, anti-bot challenge) gets half the rate; each success after that
+// brings the rate back up a little, until the configured one is reached again
+
+package browser
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// RateLimitOptions tunes a RateLimiter; zero values take the defaults
+type RateLimitOptions struct {
+	//RequestsPerMinute per site (default 30)
+	RequestsPerMinute float64
+	//Burst is how many page loads may go back to back after an idle time (default 1)
+	Burst int
+	//Jitter is the random extra wait added to every turn, up to this long (default 1s)
+	Jitter time.Duration
+	//Recovery is the rate increase per success while backed off (default 0.1 = +10%)
+	Recovery float64
+	//Sites overrides RequestsPerMinute per domain ("topcv.vn": 10)
+	Sites map[string]float64
+}
+
+// minRateFactor is how far repeated backoffs can slow a site down: 1/16 of its rate
+const minRateFactor = 1.0 / 16
+
+// RateLimiter paces page loads per domain. Safe for concurrent use.
+type RateLimiter struct {
+	opts RateLimitOptions
+	now  func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+// bucket is the token bucket of one domain, rates are in tokens per second
+type bucket struct {
+	base   float64 //configured rate
+	rate   float64 //current rate, lower after backoffs
+	tokens float64 //negative while page loads are queued
+	last   time.Time
+	paused time.Time //no token before this (Retry-After)
+}
+
+// NewRateLimiter creates a limiter with every domain at full rate
+func NewRateLimiter(opts RateLimitOptions) *RateLimiter {
+	if opts.RequestsPerMinute <= 0 {
+		opts.RequestsPerMinute = 30
+	}
+	if opts.Burst <= 0 {
+		opts.Burst = 1
+	}
+	if opts.Jitter <= 0 {
+		opts.Jitter = time.Second
+	}
+	if opts.Recovery <= 0 {
+		opts.Recovery = 0.1
+	}
+	sites := make(map[string]float64, len(opts.Sites))
+	for site, perMinute := range opts.Sites {
+		sites[Domain(site)] = perMinute
+	}
+	opts.Sites = sites
+	return &RateLimiter{opts: opts, now: time.Now, buckets: map[string]*bucket{}}
+}
+
+// Wait blocks until rawURL's site may be loaded again
+func (l *RateLimiter) Wait(ctx context.Context, rawURL string) error {
+	wait := l.reserve(Domain(rawURL)) + time.Duration(rand.Int63n(int64(l.opts.Jitter)))
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reserve takes the next token of the domain and returns how long until it is available
+func (l *RateLimiter) reserve(domain string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.bucket(domain)
+	now := l.now()
+	b.tokens = min(float64(l.opts.Burst), b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	if pause := b.paused.Sub(now); pause > wait {
+		wait = pause
+	}
+	return wait
+}
+
+// bucket returns the domain's bucket, full for a domain seen for the first time
+func (l *RateLimiter) bucket(domain string) *bucket {
+	b, ok := l.buckets[domain]
+	if !ok {
+		perMinute := l.opts.RequestsPerMinute
+		if site, ok := l.opts.Sites[domain]; ok && site > 0 {
+			perMinute = site
+		}
+		rate := perMinute / 60
+		b = &bucket{base: rate, rate: rate, tokens: float64(l.opts.Burst), last: l.now()}
+		l.buckets[domain] = b
+	}
+	return b
+}
+
+// Backoff halves the site's rate (down to 1/16 of the configured one) and drops its saved-up
+// tokens; retryAfter > 0 also pauses the site that long
+func (l *RateLimiter) Backoff(rawURL, reason string, retryAfter time.Duration) {
+	domain := Domain(rawURL)
+	l.mu.Lock()
+	b := l.bucket(domain)
+	b.rate = max(b.rate/2, b.base*minRateFactor)
+	b.tokens = min(b.tokens, 0)
+	if retryAfter > 0 {
+		b.paused = l.now().Add(retryAfter)
+	}
+	perMinute := b.rate * 60
+	l.mu.Unlock()
+
+	log.Printf("    🐢 %s: %s, slowing down to %.1f page loads/min", domain, reason, perMinute)
+}
+
+// Success raises a backed-off site's rate by Recovery, up to the configured rate
+func (l *RateLimiter) Success(rawURL string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b := l.bucket(Domain(rawURL))
+	b.rate = min(b.rate*(1+l.opts.Recovery), b.base)
+}
+
+// Rate returns the site's current page loads per minute
+func (l *RateLimiter) Rate(rawURL string) float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.bucket(Domain(rawURL)).rate * 60
+}
+
+// Observe adjusts the site's rate to a navigation's HTTP status: 429 (honoring Retry-After),
+// 403 and 503 back off, any other response counts as a success
+func (l *RateLimiter) Observe(rawURL string, status int, retryAfter string) {
+	switch status {
+	case 0:
+		//no response (navigation error): says nothing about the rate
+	case 429:
+		l.Backoff(rawURL, "HTTP 429", parseRetryAfter(retryAfter, l.now()))
+	case 403, 503:
+		l.Backoff(rawURL, "HTTP "+strconv.Itoa(status), 0)
+	default:
+		l.Success(rawURL)
+	}
+}
+
+// parseRetryAfter reads a Retry-After header: seconds or an HTTP date (0 when absent or invalid)
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second
+	}
+	if at, err := time.Parse(time.RFC1123, value); err == nil {
+		return max(at.Sub(now), 0)
+	}
+	return 0
+}
+
+// Domain is the site a URL belongs to: its host without "www." ("topcv.vn" for https://www.topcv.vn/...).
+// A bare domain is returned as is. The rate limiter and the detail tab pool both key sites by it.
+func Domain(rawURL string) string {
+	host := rawURL
+	if u, err := url.Parse(rawURL); err == nil && u.Host != "" {
+		host = u.Hostname()
+	}
+	return strings.TrimPrefix(strings.ToLower(host), "www.")
+}
+
+// navigation is the limiter every scraper shares, set once by ConfigureRateLimit before scraping starts
+var navigation = NewRateLimiter(RateLimitOptions{})
+
+// ConfigureRateLimit replaces the shared navigation limiter
+func ConfigureRateLimit(opts RateLimitOptions) {
+	navigation = NewRateLimiter(opts)
+}
+
+// Navigation returns the shared navigation limiter
+func Navigation() *RateLimiter {
+	return navigation
+}
+
+// Goto waits for the site's turn in the shared limiter, loads rawURL and adjusts the site's
+// rate to the response. Use it instead of page.Goto.
+func Goto(ctx context.Context, page playwright.Page, rawURL string, opts playwright.PageGotoOptions) (playwright.Response, error) {
+	if err := navigation.Wait(ctx, rawURL); err != nil {
+		return nil, err
+	}
+	resp, err := page.Goto(rawURL, opts)
+	if resp != nil {
+		retryAfter, _ := resp.HeaderValue("retry-after")
+		navigation.Observe(rawURL, resp.Status(), retryAfter)
+	}
+	return resp, err
+}
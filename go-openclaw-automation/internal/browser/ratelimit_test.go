@@ -0,0 +1,106 @@
+package browser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLimiter(opts RateLimitOptions) (*RateLimiter, *time.Time) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	l := NewRateLimiter(opts)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestRateLimiter_TokenBucket(t *testing.T) {
+	l, now := newTestLimiter(RateLimitOptions{RequestsPerMinute: 30, Burst: 2})
+
+	//the burst goes at once, then one page load every 2s
+	assert.Zero(t, l.reserve("topcv.vn"))
+	assert.Zero(t, l.reserve("topcv.vn"))
+	assert.Equal(t, 2*time.Second, l.reserve("topcv.vn"))
+	assert.Equal(t, 4*time.Second, l.reserve("topcv.vn"))
+
+	//other sites have their own bucket
+	assert.Zero(t, l.reserve("itviec.com"))
+
+	//idle time refills the bucket up to the burst
+	*now = now.Add(time.Minute)
+	assert.Zero(t, l.reserve("topcv.vn"))
+	assert.Zero(t, l.reserve("topcv.vn"))
+	assert.Equal(t, 2*time.Second, l.reserve("topcv.vn"))
+}
+
+func TestRateLimiter_BackoffAndRecovery(t *testing.T) {
+	l, _ := newTestLimiter(RateLimitOptions{RequestsPerMinute: 32, Recovery: 0.5, Sites: map[string]float64{"www.itviec.com": 8}})
+
+	assert.InDelta(t, 32, l.Rate("https://www.topcv.vn/viec-lam"), 0.001)
+	assert.InDelta(t, 8, l.Rate("https://itviec.com/it-jobs"), 0.001, "site override")
+
+	l.Backoff("https://www.topcv.vn/viec-lam", "HTTP 403", 0)
+	assert.InDelta(t, 16, l.Rate("https://www.topcv.vn/"), 0.001)
+
+	//never slower than 1/16 of the configured rate
+	for range 10 {
+		l.Backoff("https://www.topcv.vn/", "cloudflare challenge", 0)
+	}
+	assert.InDelta(t, 2, l.Rate("https://www.topcv.vn/"), 0.001)
+
+	//successes speed up gradually, never past the configured rate
+	l.Success("https://www.topcv.vn/")
+	assert.InDelta(t, 3, l.Rate("https://www.topcv.vn/"), 0.001)
+	for range 10 {
+		l.Success("https://www.topcv.vn/")
+	}
+	assert.InDelta(t, 32, l.Rate("https://www.topcv.vn/"), 0.001)
+}
+
+func TestRateLimiter_Observe(t *testing.T) {
+	l, _ := newTestLimiter(RateLimitOptions{RequestsPerMinute: 60})
+	searchURL := "https://www.topcv.vn/tim-viec-lam-golang"
+
+	l.Observe(searchURL, 200, "")
+	assert.InDelta(t, 60, l.Rate(searchURL), 0.001)
+
+	//a navigation error says nothing about the site
+	l.Observe(searchURL, 0, "")
+	assert.InDelta(t, 60, l.Rate(searchURL), 0.001)
+
+	//429 honors Retry-After: the next token waits for the pause
+	l.reserve("topcv.vn")
+	l.Observe(searchURL, 429, "30")
+	assert.InDelta(t, 30, l.Rate(searchURL), 0.001)
+	assert.Equal(t, 30*time.Second, l.reserve("topcv.vn"))
+
+	l.Observe(searchURL, 403, "")
+	assert.InDelta(t, 15, l.Rate(searchURL), 0.001)
+}
+
+func TestRateLimiter_WaitHonorsContext(t *testing.T) {
+	l := NewRateLimiter(RateLimitOptions{RequestsPerMinute: 1, Jitter: time.Millisecond})
+	assert.NoError(t, l.Wait(context.Background(), "https://itviec.com/"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, l.Wait(ctx, "https://itviec.com/it-jobs"), context.DeadlineExceeded)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 120*time.Second, parseRetryAfter("120", now))
+	assert.Equal(t, 90*time.Second, parseRetryAfter("Fri, 16 Oct 2026 09:01:30 GMT", now))
+	assert.Zero(t, parseRetryAfter("Fri, 16 Oct 2026 08:00:00 GMT", now))
+	assert.Zero(t, parseRetryAfter("", now))
+	assert.Zero(t, parseRetryAfter("soon", now))
+}
+
+func TestDomain(t *testing.T) {
+	assert.Equal(t, "topcv.vn", Domain("https://www.topcv.vn/viec-lam/golang?page=2"))
+	assert.Equal(t, "vn.indeed.com", Domain("https://vn.indeed.com/jobs?q=golang"))
+	assert.Equal(t, "itviec.com", Domain("www.itviec.com"))
+	assert.Equal(t, "itviec.com", Domain("ITviec.com"))
+}
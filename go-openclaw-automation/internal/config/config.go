@@ -8,7 +8,10 @@ package config
 import (
 	"log"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"gopkg.in/yaml.v3"
@@ -19,13 +22,46 @@ type Config struct {
 	TelegramChatID int64    `yaml:"telegram_chat_id" env:"TELEGRAM_CHAT_ID"`
 	Keywords       []string `yaml:"keywords"`
 	//Search criteria
-	Locations       []string `yaml:"locations"`
-	FacebookGroups  []string `yaml:"facebook_groups"`
-	ExcludeKeywords []string `yaml:"exclude_keywords"`
+	Locations []string `yaml:"locations"`
+	//Location tiers of the match score
+	LocationTiers LocationTiers `yaml:"location_tiers"`
+	//Search terms for social platforms (Twitter, Threads, ...), Keywords when empty
+	SocialKeywords []string `yaml:"social_keywords"`
+	FacebookGroups []string `yaml:"facebook_groups"`
+	//RSS 2.0 / Atom job feed URLs, read by the "feeds" platform
+	Feeds []string `yaml:"feeds"`
+	//Company career boards read by the "ats" platform (Greenhouse, Lever, Ashby)
+	ATSBoards []ATSBoard `yaml:"ats_boards"`
+	//Job search filters and modes of the "linkedin" platform
+	LinkedIn LinkedInSearch `yaml:"linkedin"`
+	//Salary threshold and score target
+	Salary          SalaryRules `yaml:"salary"`
+	ExcludeKeywords []string    `yaml:"exclude_keywords"`
+	//Platforms to run, keyed by registry name (topcv, itviec, ...)
+	EnabledPlatforms map[string]PlatformConfig `yaml:"enabled_platforms"`
+	//Caps the whole scraper run, per-platform timeouts cannot exceed it
+	RunTimeout time.Duration `yaml:"run_timeout"`
+	//Detail-page tabs open at once across every scraper, and per site (0 = scraper defaults)
+	MaxDetailTabs          int `yaml:"max_detail_tabs"`
+	MaxDetailTabsPerDomain int `yaml:"max_detail_tabs_per_domain"`
+	//Anti-bot challenge wait and evidence
+	Challenge ChallengeRules `yaml:"challenge"`
+	//Page loads per site and backoff
+	RateLimit RateLimitRules `yaml:"rate_limit"`
+	//Browser egress proxies
+	Proxy ProxyRules `yaml:"proxy"`
+	//Browser fingerprint profiles per platform
+	Fingerprint FingerprintRules `yaml:"fingerprint"`
+	//Circuit breaker of platforms that keep getting blocked
+	Health HealthRules `yaml:"health"`
 	//Paths
 	CookiesPath string `yaml:"cookies_path"`
 	CachePath   string `yaml:"cache_path"`
-	DatabaseURL string `yaml:"database_url" env:"DATABASE_URL"`
+	//How long the browserless HTTP client reuses a fetched page within a run
+	HTTPCacheTTL time.Duration `yaml:"http_cache_ttl"`
+	//Selector pack overrides (<platform>.yaml), embedded packs are used when absent
+	SelectorsPath string `yaml:"selectors_path"`
+	DatabaseURL   string `yaml:"database_url" env:"DATABASE_URL"`
 }
 
 func Load() *Config {
@@ -60,6 +96,11 @@ func Load() *Config {
 		cfg.TelegramChatID = id
 	}
 
+	//PROXY_SERVERS keeps proxy credentials out of config.yaml (comma separated)
+	if servers := os.Getenv("PROXY_SERVERS"); servers != "" {
+		cfg.Proxy.Servers = strings.Split(servers, ",")
+	}
+
 	//Set default values if not set
 	if cfg.CookiesPath == "" {
 		cfg.CookiesPath = "../.cookies"
@@ -69,6 +110,34 @@ func Load() *Config {
 		cfg.CachePath = "../.cache"
 	}
 
+	if cfg.Proxy.Assign == "" {
+		cfg.Proxy.Assign = "context"
+	}
+
+	if cfg.Health.StateFile == "" {
+		cfg.Health.StateFile = filepath.Join(cfg.CachePath, "platform-health.json")
+	}
+
+	if cfg.Fingerprint.StateFile == "" {
+		cfg.Fingerprint.StateFile = filepath.Join(cfg.CachePath, "fingerprints.json")
+	}
+
+	if cfg.RunTimeout == 0 {
+		cfg.RunTimeout = 10 * time.Minute
+	}
+
+	if cfg.HTTPCacheTTL == 0 {
+		cfg.HTTPCacheTTL = 10 * time.Minute
+	}
+
+	if cfg.Salary.USDToVND == 0 {
+		cfg.Salary.USDToVND = 25000
+	}
+
+	if cfg.SelectorsPath == "" {
+		cfg.SelectorsPath = "configs/selectors"
+	}
+
 	//Validate required fields
 	if cfg.TelegramToken == "" {
 		log.Fatal("TELEGRAM_BOT_TOKEN is required")
@@ -78,5 +147,20 @@ func Load() *Config {
 		log.Fatal("TELEGRAM_CHAT_ID is required")
 	}
 
+	if cfg.Proxy.Assign != "context" && cfg.Proxy.Assign != "platform" {
+		log.Fatalf("Invalid proxy.assign %q: must be context or platform", cfg.Proxy.Assign)
+	}
+
+	for name, platform := range cfg.EnabledPlatforms {
+		if platform.Timeout > cfg.RunTimeout {
+			log.Printf("Warning: %s.timeout %s exceeds run_timeout %s, the run ends first", name, platform.Timeout, cfg.RunTimeout)
+		}
+	}
+
 	return cfg
 }
+
+// Platform returns the options for a platform (zero value if it is not configured)
+func (c *Config) Platform(name string) PlatformConfig {
+	return c.EnabledPlatforms[name]
+}
@@ -1 +1,119 @@
 package config
+
+import "time"
+
+// PlatformConfig holds the per-platform options under `enabled_platforms` in config.yaml
+type PlatformConfig struct {
+	Enabled bool `yaml:"enabled"`
+	//Timeout caps a single run of the platform scraper (0 = run_timeout)
+	Timeout time.Duration `yaml:"timeout"`
+	//Pagination budget: pages per search and cards per run (0 = scraper default)
+	MaxPages int `yaml:"max_pages"`
+	MaxCards int `yaml:"max_cards"`
+	//Post caps for social group scrapers (0 = scraper default), same meaning as the Node platformConfigs
+	MaxPostsPerGroup   int `yaml:"max_posts_per_group"`    //posts inspected per group
+	MaxNewJobsPerGroup int `yaml:"max_new_jobs_per_group"` //stop a group after this many valid jobs
+	StopAfterTotalJobs int `yaml:"stop_after_total_jobs"`  //stop the run after this many valid jobs
+	//Levels keeps jobs of these seniority levels for boards that label them (empty = scraper default)
+	Levels []string `yaml:"levels"`
+}
+
+// ATSBoard is a company career board served by an applicant tracking system's public JSON API
+type ATSBoard struct {
+	Company string `yaml:"company"`
+	//ATS is greenhouse, lever or ashby
+	ATS string `yaml:"ats"`
+	//BoardToken is the company slug in the board URL (boards.greenhouse.io/<token>, jobs.lever.co/<token>, jobs.ashbyhq.com/<token>)
+	BoardToken string `yaml:"board_token"`
+}
+
+// LinkedInSearch holds the job search filters of the "linkedin" platform (scraper defaults when empty)
+type LinkedInSearch struct {
+	//ExperienceLevels: internship, entry, associate, mid_senior, director, executive (or LinkedIn's f_E codes)
+	ExperienceLevels []string `yaml:"experience_levels"`
+	//WorkTypes: onsite, remote, hybrid (or LinkedIn's f_WT codes)
+	WorkTypes []string `yaml:"work_types"`
+	//GeoID is LinkedIn's location id (104195383 = Vietnam)
+	GeoID string `yaml:"geo_id"`
+	//PostedWithin only keeps jobs posted in this window (f_TPR)
+	PostedWithin time.Duration `yaml:"posted_within"`
+	//Modes: "jobs" (job search) and/or "posts" (hiring posts in content search)
+	Modes []string `yaml:"modes"`
+}
+
+// SalaryRules holds the salary filter and scoring thresholds, in VND per month (0 = rule off)
+type SalaryRules struct {
+	//MinMonthlyVND drops jobs whose whole salary range is below it (jobs without a salary are kept)
+	MinMonthlyVND int64 `yaml:"min_monthly_vnd"`
+	//TargetMonthlyVND adds a match score bonus to jobs whose range reaches it
+	TargetMonthlyVND int64 `yaml:"target_monthly_vnd"`
+	//USDToVND converts USD salaries (default 25000)
+	USDToVND float64 `yaml:"usd_to_vnd"`
+}
+
+// LocationTiers ranks job locations for the match score. Entries are free text in any spelling
+// ("TP.HCM", "Cần Thơ", "Đà Nẵng") or a work mode ("remote", "hybrid", "onsite").
+type LocationTiers struct {
+	//Primary locations score +2 (Locations when empty)
+	Primary []string `yaml:"primary"`
+	//Secondary locations score +1
+	Secondary []string `yaml:"secondary"`
+}
+
+// ChallengeRules tunes how anti-bot challenges are handled (browser.ChallengeOptions, 0 = default)
+type ChallengeRules struct {
+	//Wait is how long a Cloudflare/Turnstile interstitial may take to clear (default 15s)
+	Wait time.Duration `yaml:"wait"`
+	//RecheckInterval is how often the page is checked again while waiting (default 2s)
+	RecheckInterval time.Duration `yaml:"recheck_interval"`
+	//EvidenceDir receives a screenshot and the HTML of pages that stay blocked (default logs/challenges)
+	EvidenceDir string `yaml:"evidence_dir"`
+}
+
+// HealthRules tunes the per-platform circuit breaker (health.Options, 0 = default)
+type HealthRules struct {
+	//StateFile keeps run outcomes between runs (default <cache_path>/platform-health.json)
+	StateFile string `yaml:"state_file"`
+	//BlockedThreshold consecutive blocked runs skip the platform for Cooldown (default 3)
+	BlockedThreshold int `yaml:"blocked_threshold"`
+	//FailedThreshold consecutive failed or timed out runs raise an alert (default 3)
+	FailedThreshold int `yaml:"failed_threshold"`
+	//Cooldown of the first skip, doubled every time the platform is blocked again, up to MaxCooldown
+	Cooldown    time.Duration `yaml:"cooldown"`
+	MaxCooldown time.Duration `yaml:"max_cooldown"`
+}
+
+// ProxyRules routes browser contexts through proxies (none = direct connection)
+type ProxyRules struct {
+	//Servers are http://, https:// or socks5:// URLs, credentials as user:pass@host:port
+	Servers []string `yaml:"servers"`
+	//Assign is "context" (every platform's context leaves through the same proxy, default) or
+	//"platform" (a sticky proxy per platform)
+	Assign string `yaml:"assign"`
+	//RotateOnChallenge retries a blocked platform once on the next proxy (platform assignment only)
+	RotateOnChallenge bool `yaml:"rotate_on_challenge"`
+	//Cooldown keeps a challenged proxy out of rotation this long (default 30m)
+	Cooldown time.Duration `yaml:"cooldown"`
+}
+
+// FingerprintRules picks the browser fingerprint profile of every platform
+type FingerprintRules struct {
+	//StateFile keeps each platform's profile across runs (default <cache_path>/fingerprints.json)
+	StateFile string `yaml:"state_file"`
+	//Platforms pins a built-in profile on a platform (windows-laptop, windows-desktop, macbook)
+	Platforms map[string]string `yaml:"platforms"`
+}
+
+// RateLimitRules paces browser page loads per site (browser.RateLimitOptions, 0 = default)
+type RateLimitRules struct {
+	//RequestsPerMinute per site (default 30), halved on 429/403/challenges and recovered after successes
+	RequestsPerMinute float64 `yaml:"requests_per_minute"`
+	//Burst page loads may go back to back after an idle time (default 1)
+	Burst int `yaml:"burst"`
+	//Jitter is the random extra wait of every page load, up to this long (default 1s)
+	Jitter time.Duration `yaml:"jitter"`
+	//Recovery is the rate increase per success while backed off (default 0.1 = +10%)
+	Recovery float64 `yaml:"recovery"`
+	//Sites overrides requests_per_minute per domain
+	Sites map[string]float64 `yaml:"sites"`
+}
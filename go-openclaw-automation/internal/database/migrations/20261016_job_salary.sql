@@ -0,0 +1,6 @@
+-- Structured salary range parsed from jobs.salary (see scraper.ParseSalary), applied once by EnsureSchema
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS salary_min        BIGINT;
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS salary_max        BIGINT;
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS salary_currency   TEXT    NOT NULL DEFAULT '';
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS salary_period     TEXT    NOT NULL DEFAULT '';
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS salary_negotiable BOOLEAN NOT NULL DEFAULT false;
@@ -39,7 +39,14 @@ func ConnectDB(ctx context.Context, connString string) (*Repository, error) {
 		return nil, fmt.Errorf("database unreachable: %w", err)
 	}
 
-	return &Repository{db: pool}, nil
+	// SaveJob and GetJobByID need the columns added by the migrations
+	repo := &Repository{db: pool}
+	if err := repo.EnsureSchema(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return repo, nil
 }
 
 func (r *Repository) Close() {
@@ -88,28 +95,29 @@ func (r *Repository) UpdateUserResume(ctx context.Context, userID string, master
 // SaveJob inserts a new job or updates an existing one (based on source + external_id)
 func (r *Repository) SaveJob(ctx context.Context, job *models.Job) (*models.Job, error) {
 	query := `
-		INSERT INTO jobs (source, external_id, title, company, url, location, salary, match_score, posted_at, description_raw, description_summary)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		INSERT INTO jobs (source, external_id, title, company, url, location, salary, salary_min, salary_max, salary_currency, salary_period, salary_negotiable, match_score, posted_at, description_raw, description_summary)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
 		ON CONFLICT (source, external_id)
 		DO UPDATE SET
-			title           = EXCLUDED.title,
-			company         = EXCLUDED.company,
-			location        = EXCLUDED.location,
-			salary          = EXCLUDED.salary,
-			match_score     = EXCLUDED.match_score,
-			posted_at       = EXCLUDED.posted_at,
-			description_raw = EXCLUDED.description_raw
-		RETURNING id, source, external_id, title, company, url, location, salary, match_score, posted_at, description_raw, description_summary, created_at`
+			title             = EXCLUDED.title,
+			company           = EXCLUDED.company,
+			location          = EXCLUDED.location,
+			salary            = EXCLUDED.salary,
+			salary_min        = EXCLUDED.salary_min,
+			salary_max        = EXCLUDED.salary_max,
+			salary_currency   = EXCLUDED.salary_currency,
+			salary_period     = EXCLUDED.salary_period,
+			salary_negotiable = EXCLUDED.salary_negotiable,
+			match_score       = EXCLUDED.match_score,
+			posted_at         = EXCLUDED.posted_at,
+			description_raw   = EXCLUDED.description_raw
+		RETURNING ` + jobColumns
 
 	err := r.db.QueryRow(ctx, query,
 		job.Source, job.ExternalID, job.Title, job.Company, job.URL,
-		job.Location, job.Salary, job.MatchScore, job.PostedAt,
-		job.DescriptionRaw, job.DescriptionSummary,
-	).Scan(
-		&job.ID, &job.Source, &job.ExternalID, &job.Title, &job.Company, &job.URL,
-		&job.Location, &job.Salary, &job.MatchScore, &job.PostedAt,
-		&job.DescriptionRaw, &job.DescriptionSummary, &job.CreatedAt,
-	)
+		job.Location, job.Salary, job.SalaryMin, job.SalaryMax, job.SalaryCurrency, job.SalaryPeriod, job.SalaryNegotiable,
+		job.MatchScore, job.PostedAt, job.DescriptionRaw, job.DescriptionSummary,
+	).Scan(jobFields(job)...)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to save job: %w", err)
@@ -118,17 +126,22 @@ func (r *Repository) SaveJob(ctx context.Context, job *models.Job) (*models.Job,
 	return job, nil
 }
 
+// jobColumns are the jobs columns read back into a models.Job, in jobFields order
+const jobColumns = `id, source, external_id, title, company, url, location, salary, salary_min, salary_max, salary_currency, salary_period, salary_negotiable, match_score, posted_at, description_raw, description_summary, created_at`
+
+func jobFields(job *models.Job) []any {
+	return []any{
+		&job.ID, &job.Source, &job.ExternalID, &job.Title, &job.Company, &job.URL,
+		&job.Location, &job.Salary, &job.SalaryMin, &job.SalaryMax, &job.SalaryCurrency, &job.SalaryPeriod, &job.SalaryNegotiable,
+		&job.MatchScore, &job.PostedAt, &job.DescriptionRaw, &job.DescriptionSummary, &job.CreatedAt,
+	}
+}
+
 // GetJobByID retrieves a job by its UUID
 func (r *Repository) GetJobByID(ctx context.Context, jobID string) (*models.Job, error) {
 	var job models.Job
-	query := `
-		SELECT id, source, external_id, title, company, url, location, salary, match_score, posted_at, description_raw, description_summary, created_at
-		FROM jobs WHERE id = $1`
-	err := r.db.QueryRow(ctx, query, jobID).Scan(
-		&job.ID, &job.Source, &job.ExternalID, &job.Title, &job.Company, &job.URL,
-		&job.Location, &job.Salary, &job.MatchScore, &job.PostedAt,
-		&job.DescriptionRaw, &job.DescriptionSummary, &job.CreatedAt,
-	)
+	query := `SELECT ` + jobColumns + ` FROM jobs WHERE id = $1`
+	err := r.db.QueryRow(ctx, query, jobID).Scan(jobFields(&job)...)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, fmt.Errorf("job not found")
@@ -0,0 +1,99 @@
+package database
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// migrations are applied once each, in file name order; applied names are recorded in
+// schema_migrations so a normal connect only reads that table (no DDL, no lock on jobs)
+//
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+const createMigrationsTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	name       TEXT PRIMARY KEY,
+	applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`
+
+// EnsureSchema applies the migrations the database has not recorded yet
+func (r *Repository) EnsureSchema(ctx context.Context) error {
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return fmt.Errorf("failed to list migrations: %w", err)
+	}
+	sort.Strings(names)
+
+	applied, err := r.appliedMigrations(ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if applied[name] {
+			continue
+		}
+		if err := r.applyMigration(ctx, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appliedMigrations reads schema_migrations, creating it only when it is missing
+func (r *Repository) appliedMigrations(ctx context.Context) (map[string]bool, error) {
+	var exists bool
+	if err := r.db.QueryRow(ctx, `SELECT to_regclass('schema_migrations') IS NOT NULL`).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to check schema_migrations: %w", err)
+	}
+	if !exists {
+		if _, err := r.db.Exec(ctx, createMigrationsTable); err != nil {
+			return nil, fmt.Errorf("failed to create schema_migrations: %w", err)
+		}
+	}
+
+	rows, err := r.db.Query(ctx, `SELECT name FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+
+	applied := make(map[string]bool, len(names))
+	for _, name := range names {
+		applied[name] = true
+	}
+	return applied, nil
+}
+
+// applyMigration runs one migration file and records it in the same transaction
+func (r *Repository) applyMigration(ctx context.Context, name string) error {
+	ddl, err := migrations.ReadFile(name)
+	if err != nil {
+		return fmt.Errorf("failed to read migration %s: %w", name, err)
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin migration %s: %w", name, err)
+	}
+	defer tx.Rollback(ctx)
+
+	//a file holds several statements, which only the simple protocol accepts
+	if _, err := tx.Exec(ctx, string(ddl), pgx.QueryExecModeSimpleProtocol); err != nil {
+		return fmt.Errorf("failed to apply migration %s: %w", name, err)
+	}
+	if _, err := tx.Exec(ctx, `INSERT INTO schema_migrations (name) VALUES ($1)`, name); err != nil {
+		return fmt.Errorf("failed to record migration %s: %w", name, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit migration %s: %w", name, err)
+	}
+	return nil
+}
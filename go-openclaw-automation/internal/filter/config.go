@@ -0,0 +1,36 @@
+package filter
+
+import "go-openclaw-automation/internal/config"
+
+// defaultPrimaryLocations is the tier used when config has neither location_tiers.primary nor locations
+var defaultPrimaryLocations = []string{"Cần Thơ", "Hồ Chí Minh", "remote"}
+
+// settings are the config-driven rules, set once by Configure before scraping starts
+var settings = struct {
+	salary             config.SalaryRules
+	primaryLocations   locationTier
+	secondaryLocations locationTier
+}{
+	salary:             config.SalaryRules{USDToVND: 25000},
+	primaryLocations:   newLocationTier(defaultPrimaryLocations),
+	secondaryLocations: newLocationTier(nil),
+}
+
+// Configure applies the filter rules of config.yaml (salary threshold and target, location tiers)
+func Configure(cfg *config.Config) {
+	settings.salary = cfg.Salary
+	if settings.salary.USDToVND == 0 {
+		settings.salary.USDToVND = 25000
+	}
+
+	//the search locations are what we want most when no tier is configured
+	primary := cfg.LocationTiers.Primary
+	if len(primary) == 0 {
+		primary = cfg.Locations
+	}
+	if len(primary) == 0 {
+		primary = defaultPrimaryLocations
+	}
+	settings.primaryLocations = newLocationTier(primary)
+	settings.secondaryLocations = newLocationTier(cfg.LocationTiers.Secondary)
+}
@@ -0,0 +1,36 @@
+package filter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsRecentJob(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		date     string
+		expected bool
+	}{
+		{name: "ISO date", date: now.AddDate(0, 0, -10).Format("2006-01-02"), expected: true},
+		{name: "Old ISO date", date: now.AddDate(0, 0, -90).Format("2006-01-02"), expected: false},
+		{name: "Old dd/mm/yyyy", date: now.AddDate(0, 0, -61).Format("02/01/2006"), expected: false},
+		{name: "Future date", date: now.AddDate(0, 0, 5).Format("2006-01-02"), expected: false},
+		{name: "Relative days", date: "3 ngày trước", expected: true},
+		{name: "Relative weeks", date: "Reposted 2 weeks ago", expected: true},
+		{name: "Relative months", date: "3 tháng trước", expected: false},
+		{name: "Thirty plus days", date: "Đã đăng 30+ ngày trước", expected: true},
+		{name: "Last year only", date: "Posted in " + now.AddDate(-1, 0, 0).Format("2006"), expected: true},
+		{name: "Old year only", date: "Posted in 2019", expected: false},
+		{name: "No date", date: "Recent", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRecentJob(tt.date); got != tt.expected {
+				t.Errorf("IsRecentJob(%q) = %v, want %v", tt.date, got, tt.expected)
+			}
+		})
+	}
+}
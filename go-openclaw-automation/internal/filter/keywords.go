@@ -2,10 +2,11 @@ package filter
 
 import (
 	"go-openclaw-automation/internal/scraper"
+	"go-openclaw-automation/utils"
 )
 
 func ShouldIncludeJob(job scraper.Job) bool {
-	text := normalizeText(job.Title + " " + job.Description)
+	text := utils.NormalizeText(job.Title + " " + job.Description)
 	//must contain golang/go
 	if !keywordRegex.MatchString(text){
 		return false
@@ -26,5 +27,16 @@ func ShouldIncludeJob(job scraper.Job) bool {
 		return false
 	}
 
+	//must not pay less than the configured minimum
+	if !meetsMinSalary(job) {
+		return false
+	}
+
 	return true
-}
\ No newline at end of file
+}
+// IsExcludedLevel reports a title that is out of our level (senior, lead, 3+ years, ...),
+// so list-page scrapers can skip it before opening the detail page
+func IsExcludedLevel(title string) bool {
+	text := utils.NormalizeText(title)
+	return excludeRegex.MatchString(text) || experienceRegex.MatchString(text)
+}
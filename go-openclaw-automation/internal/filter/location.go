@@ -0,0 +1,208 @@
+package filter
+
+import (
+	"go-openclaw-automation/utils"
+	"regexp"
+	"strings"
+)
+
+var globalRegex = regexp.MustCompile(`\b(global|worldwide|world wide|anywhere|from anywhere|international)\b`)
+
+// LocationInfo is what a free-text post (tweet, group post) says about where the job is
+type LocationInfo struct {
+	HCM    bool
+	CanTho bool
+	Remote bool
+	Global bool
+	Hanoi  bool
+	//Preferred is HCM, Can Tho, Remote, Global, Hanoi or Unknown (first match in that order)
+	Preferred string
+}
+
+// HasPreferred reports a location we want (anything but Hanoi)
+func (l LocationInfo) HasPreferred() bool {
+	return l.HCM || l.CanTho || l.Remote || l.Global
+}
+
+// HanoiOnly reports posts that only mention Hanoi
+func (l LocationInfo) HanoiOnly() bool {
+	return l.Hanoi && !l.HasPreferred()
+}
+
+// AnalyzeLocation scans free text (tweets, group posts) for the cities we care about, with the
+// vocabulary of NormalizeLocation minus the abbreviations that are ambiguous in a post
+func AnalyzeLocation(text string) LocationInfo {
+	location := normalizeLocation(text, false)
+	info := LocationInfo{
+		HCM:    location.Has(CityHoChiMinh),
+		CanTho: location.Has(CityCanTho),
+		Remote: location.Mode == ModeRemote || location.Mode == ModeHybrid,
+		Global: globalRegex.MatchString(normalizeLocationText(text)),
+		Hanoi:  location.Has(CityHanoi),
+	}
+
+	switch {
+	case info.HCM:
+		info.Preferred = "HCM"
+	case info.CanTho:
+		info.Preferred = "Can Tho"
+	case info.Remote:
+		info.Preferred = "Remote"
+	case info.Global:
+		info.Preferred = "Global"
+	case info.Hanoi:
+		info.Preferred = "Hanoi"
+	default:
+		info.Preferred = "Unknown"
+	}
+	return info
+}
+
+// Canonical city names returned by NormalizeLocation
+const (
+	CityHoChiMinh = "Ho Chi Minh"
+	CityHanoi     = "Ha Noi"
+	CityCanTho    = "Can Tho"
+	CityDaNang    = "Da Nang"
+	CityHaiPhong  = "Hai Phong"
+	CityBinhDuong = "Binh Duong"
+	CityDongNai   = "Dong Nai"
+)
+
+// WorkMode is where the work happens, empty when the text does not say
+type WorkMode string
+
+const (
+	ModeUnknown WorkMode = ""
+	ModeOnsite  WorkMode = "onsite"
+	ModeHybrid  WorkMode = "hybrid"
+	ModeRemote  WorkMode = "remote"
+)
+
+// cityAliases are the spellings of each city (and its well-known districts) after normalizeLocationText;
+// fieldAliases are abbreviations only read in location fields, too ambiguous in post bodies
+var cityAliases = []struct {
+	city         string
+	aliases      []string
+	fieldAliases []string
+}{
+	{CityHoChiMinh, []string{"ho chi minh", "hochiminh", "hcm", "hcmc", "tp hcm", "tphcm", "sai gon", "saigon",
+		"thu duc", "binh thanh", "go vap", "tan binh", "tan phu", "phu nhuan", "binh tan", "binh chanh", "nha be", "hoc mon", "cu chi"}, nil},
+	{CityHanoi, []string{"ha noi", "hanoi", "thu do", "cau giay", "hoan kiem", "ba dinh", "dong da",
+		"hai ba trung", "thanh xuan", "nam tu liem", "bac tu liem", "tay ho", "long bien", "hoang mai", "ha dong"}, []string{"hn"}},
+	{CityCanTho, []string{"can tho", "cantho", "ninh kieu", "cai rang", "binh thuy"}, nil},
+	{CityDaNang, []string{"da nang", "danang", "hai chau", "son tra", "ngu hanh son", "thanh khe", "lien chieu"}, nil},
+	{CityHaiPhong, []string{"hai phong", "haiphong"}, nil},
+	{CityBinhDuong, []string{"binh duong", "thu dau mot"}, nil},
+	{CityDongNai, []string{"dong nai", "bien hoa"}, nil},
+}
+
+var (
+	//"Quận 1", "District 7": only Ho Chi Minh City numbers its districts
+	hcmDistrictRegex = regexp.MustCompile(`\b(quan|district)\s?(1[0-2]|[1-9])\b`)
+	//"Q.3" keeps its dot (matched before punctuation is stripped); a bare "Q1" is a fiscal quarter
+	//in a post, so it only counts in location fields
+	hcmShortDistrictRegex = regexp.MustCompile(`\bq\.\s?(1[0-2]|[1-9])\b`)
+	hcmFieldDistrictRegex = regexp.MustCompile(`\bq\s?(1[0-2]|[1-9])\b`)
+	hybridModeRegex       = regexp.MustCompile(`\b(hybrid|ket hop|ban tu xa)\b`)
+	remoteModeRegex       = regexp.MustCompile(`\b(remote|tu xa|work from home|wfh|online)\b`)
+	onsiteModeRegex       = regexp.MustCompile(`\b(onsite|on site|in office|tai van phong|office)\b`)
+	//anything but letters and digits separates words ("TP.HCM" -> "tp hcm", "Hybrid - HCM" -> "hybrid hcm")
+	locationSeparatorRegex = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// Location is a job location reduced to canonical cities and a work mode
+type Location struct {
+	//Cities in cityAliases order, without duplicates
+	Cities []string
+	Mode   WorkMode
+}
+
+// Has reports whether city is one of the location's cities
+func (l Location) Has(city string) bool {
+	for _, c := range l.Cities {
+		if c == city {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeLocation maps a location field ("TP.HCM", "Quận 1, Hồ Chí Minh", "Sài Gòn",
+// "Làm từ xa", "Hybrid - HCM", "Q1, HN") to canonical cities and a work mode
+func NormalizeLocation(text string) Location {
+	return normalizeLocation(text, true)
+}
+
+// normalizeLocation reads abbreviations ("HN", "Q1") only when text is a location field,
+// not a post body
+func normalizeLocation(text string, field bool) Location {
+	normalized := normalizeLocationText(text)
+	padded := " " + normalized + " "
+
+	var location Location
+	for _, entry := range cityAliases {
+		aliases := entry.aliases
+		if field {
+			aliases = append(aliases[:len(aliases):len(aliases)], entry.fieldAliases...)
+		}
+		for _, alias := range aliases {
+			if strings.Contains(padded, " "+alias+" ") {
+				location.Cities = append(location.Cities, entry.city)
+				break
+			}
+		}
+	}
+	district := hcmDistrictRegex.MatchString(normalized) || hcmShortDistrictRegex.MatchString(utils.NormalizeText(text)) ||
+		(field && hcmFieldDistrictRegex.MatchString(normalized))
+	if district && !location.Has(CityHoChiMinh) {
+		location.Cities = append([]string{CityHoChiMinh}, location.Cities...)
+	}
+
+	//"Hybrid - remote 2 days/week" is hybrid
+	switch {
+	case hybridModeRegex.MatchString(normalized):
+		location.Mode = ModeHybrid
+	case remoteModeRegex.MatchString(normalized):
+		location.Mode = ModeRemote
+	case onsiteModeRegex.MatchString(normalized):
+		location.Mode = ModeOnsite
+	}
+	return location
+}
+
+// normalizeLocationText strips accents and punctuation ("TP. Hồ Chí Minh" -> "tp ho chi minh")
+func normalizeLocationText(text string) string {
+	return strings.TrimSpace(locationSeparatorRegex.ReplaceAllString(utils.NormalizeText(text), " "))
+}
+
+// locationTier is a set of cities and work modes read from config (see config.LocationTiers)
+type locationTier struct {
+	cities map[string]bool
+	modes  map[WorkMode]bool
+}
+
+// newLocationTier normalizes each entry; an entry naming a mode and no city ("remote") is a mode
+func newLocationTier(entries []string) locationTier {
+	tier := locationTier{cities: map[string]bool{}, modes: map[WorkMode]bool{}}
+	for _, entry := range entries {
+		location := NormalizeLocation(entry)
+		for _, city := range location.Cities {
+			tier.cities[city] = true
+		}
+		if len(location.Cities) == 0 && location.Mode != ModeUnknown {
+			tier.modes[location.Mode] = true
+		}
+	}
+	return tier
+}
+
+// matches reports a location in one of the tier's cities or work modes
+func (t locationTier) matches(location Location) bool {
+	for _, city := range location.Cities {
+		if t.cities[city] {
+			return true
+		}
+	}
+	return t.modes[location.Mode]
+}
@@ -0,0 +1,133 @@
+package filter
+
+import (
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper"
+	"slices"
+	"testing"
+)
+
+func TestAnalyzeLocation(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		preferred string
+		hanoiOnly bool
+	}{
+		{name: "HCM with accents", text: "Tuyển Golang dev tại Hồ Chí Minh", preferred: "HCM"},
+		{name: "Sai Gon", text: "Golang intern, văn phòng Sài Gòn", preferred: "HCM"},
+		{name: "Can Tho", text: "Golang fresher - Cần Thơ", preferred: "Can Tho"},
+		{name: "Remote", text: "Go backend, làm từ xa", preferred: "Remote"},
+		{name: "Hanoi only", text: "Golang engineer, onsite Hà Nội", preferred: "Hanoi", hanoiOnly: true},
+		{name: "Hanoi and HCM", text: "Golang dev, Hà Nội hoặc HCM", preferred: "HCM"},
+		{name: "HCM district", text: "Golang dev, văn phòng Quận 1", preferred: "HCM"},
+		{name: "Thu Duc", text: "Tuyển Golang intern tại Thủ Đức", preferred: "HCM"},
+		{name: "Hanoi district", text: "Golang dev, văn phòng Cầu Giấy", preferred: "Hanoi", hanoiOnly: true},
+		{name: "Hanoi hybrid", text: "Golang dev, hybrid, Đống Đa", preferred: "Remote"},
+		{name: "Global", text: "Golang engineer, work from anywhere", preferred: "Global"},
+		{name: "Q dot district", text: "Golang dev, văn phòng Q.3", preferred: "HCM"},
+		{name: "Fiscal quarter", text: "Hiring Golang devs for our Q1 roadmap", preferred: "Unknown"},
+		{name: "Bare HN in a post", text: "Golang dev, HN cần gấp", preferred: "Unknown"},
+		{name: "Unknown", text: "Golang developer wanted", preferred: "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := AnalyzeLocation(tt.text)
+			if info.Preferred != tt.preferred {
+				t.Errorf("preferred: got %q, want %q", info.Preferred, tt.preferred)
+			}
+			if info.HanoiOnly() != tt.hanoiOnly {
+				t.Errorf("hanoi only: got %v, want %v", info.HanoiOnly(), tt.hanoiOnly)
+			}
+		})
+	}
+}
+
+func TestNormalizeLocation(t *testing.T) {
+	tests := []struct {
+		text   string
+		cities []string
+		mode   WorkMode
+	}{
+		{text: "TP.HCM", cities: []string{CityHoChiMinh}},
+		{text: "Quận 1, Hồ Chí Minh", cities: []string{CityHoChiMinh}},
+		{text: "Quận 7", cities: []string{CityHoChiMinh}},
+		{text: "Sài Gòn", cities: []string{CityHoChiMinh}},
+		{text: "Thành phố Thủ Đức", cities: []string{CityHoChiMinh}},
+		{text: "Cần Thơ", cities: []string{CityCanTho}},
+		{text: "Ninh Kiều, Cần Thơ", cities: []string{CityCanTho}},
+		{text: "Cầu Giấy, Hà Nội", cities: []string{CityHanoi}},
+		{text: "Hà Nội, Đà Nẵng", cities: []string{CityHanoi, CityDaNang}},
+		{text: "Làm từ xa", mode: ModeRemote},
+		{text: "Remote (Vietnam)", mode: ModeRemote},
+		{text: "Hybrid - HCM", cities: []string{CityHoChiMinh}, mode: ModeHybrid},
+		{text: "Ho Chi Minh City (On-site)", cities: []string{CityHoChiMinh}, mode: ModeOnsite},
+		{text: "Q1", cities: []string{CityHoChiMinh}},
+		{text: "HN", cities: []string{CityHanoi}},
+		{text: "Vietnam"},
+		{text: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got := NormalizeLocation(tt.text)
+			if !slices.Equal(got.Cities, tt.cities) {
+				t.Errorf("cities: got %v, want %v", got.Cities, tt.cities)
+			}
+			if got.Mode != tt.mode {
+				t.Errorf("mode: got %q, want %q", got.Mode, tt.mode)
+			}
+		})
+	}
+}
+
+func TestCalculateMatchScore_LocationTiers(t *testing.T) {
+	previous := settings
+	t.Cleanup(func() { settings = previous })
+	Configure(&config.Config{
+		Locations: []string{"ha noi"},
+		LocationTiers: config.LocationTiers{
+			Primary:   []string{"TP.HCM", "remote"},
+			Secondary: []string{"Đà Nẵng", "hybrid"},
+		},
+	})
+
+	job := scraper.Job{Title: "Golang Developer"}
+	base := CalculateMatchScore(job)
+
+	tests := []struct {
+		location string
+		expected int
+	}{
+		{location: "Quận 1, Hồ Chí Minh", expected: base + 2},
+		{location: "Làm từ xa", expected: base + 2},
+		{location: "Hybrid - HCM", expected: base + 2},
+		{location: "Đà Nẵng", expected: base + 1},
+		{location: "Hybrid - Hà Nội", expected: base + 1},
+		{location: "Hà Nội", expected: base},
+		{location: "Cần Thơ", expected: base},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.location, func(t *testing.T) {
+			job.Location = tt.location
+			if got := CalculateMatchScore(job); got != tt.expected {
+				t.Errorf("CalculateMatchScore(location %q) = %d, want %d", tt.location, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConfigure_PrimaryLocationsDefaultToSearchLocations(t *testing.T) {
+	previous := settings
+	t.Cleanup(func() { settings = previous })
+	Configure(&config.Config{Locations: []string{"đà nẵng"}})
+
+	if !matchesPrimaryLocation("Hải Châu, Đà Nẵng") {
+		t.Error("search location should be primary when location_tiers is empty")
+	}
+	if matchesPrimaryLocation("Cần Thơ") {
+		t.Error("Cần Thơ is not configured")
+	}
+}
@@ -2,13 +2,9 @@ package filter
 
 import (
 	"go-openclaw-automation/internal/scraper"
+	"go-openclaw-automation/utils"
 	"regexp"
 	"strings"
-	"unicode"
-
-	"golang.org/x/text/runes"
-	"golang.org/x/text/transform"
-	"golang.org/x/text/unicode/norm"
 )
 
 var (
@@ -22,7 +18,7 @@ var (
 func CalculateMatchScore(job scraper.Job) int {
 	score := 0
 	//normalize text to remove accents
-	text := normalizeText(job.Title + " " + job.Description + " " + job.Company)
+	text := utils.NormalizeText(job.Title + " " + job.Description + " " + job.Company)
 
 	//golang mention (+3)
 	if keywordRegex.MatchString(text) {
@@ -47,6 +43,11 @@ func CalculateMatchScore(job scraper.Job) int {
 		score += 1
 	}
 
+	//salary reaches the configured target
+	if reachesTargetSalary(job) {
+		score += 1
+	}
+
 	//penalty: exp >= 3 years => -5
 	if experienceRegex.MatchString(text) {
 		return 0
@@ -62,23 +63,12 @@ func CalculateMatchScore(job scraper.Job) int {
 	return score
 }
 
-func normalizeText(str string) string {
-	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
-	result, _, _ := transform.String(t, str)
-	return strings.ToLower(result)
-}
-
+// matchesPrimaryLocation reports a location in the primary tier of config (+2)
 func matchesPrimaryLocation(location string) bool {
-	primary := []string{"cần thơ", "can tho", "remote", "từ xa", "hồ chí minh", "ho chi minh", "hcm", "saigon", "tphcm"}
-	for _, loc := range primary {
-		if strings.Contains(location, loc) {
-			return true
-		}
-	}
-	return false
+	return settings.primaryLocations.matches(NormalizeLocation(location))
 }
 
-// Currently not used
+// matchesSecondaryLocation reports a location in the secondary tier of config (+1)
 func matchesSecondaryLocation(location string) bool {
-	return false
+	return settings.secondaryLocations.matches(NormalizeLocation(location))
 }
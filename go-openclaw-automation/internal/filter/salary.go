@@ -0,0 +1,30 @@
+package filter
+
+import "go-openclaw-automation/internal/scraper"
+
+// meetsMinSalary reports whether the job may pay at least the configured minimum.
+// Jobs without an amount or a currency, or open-ended ones ("Từ 15 triệu"), are kept.
+func meetsMinSalary(job scraper.Job) bool {
+	if settings.salary.MinMonthlyVND <= 0 {
+		return true
+	}
+	salary := scraper.ParseSalary(job.Salary)
+	if salary.Max == 0 || salary.Currency == "" {
+		return true
+	}
+	_, high := salary.Monthly(settings.salary.USDToVND)
+	return high >= settings.salary.MinMonthlyVND
+}
+
+// reachesTargetSalary reports whether the top of the job's range reaches the configured target
+func reachesTargetSalary(job scraper.Job) bool {
+	if settings.salary.TargetMonthlyVND <= 0 {
+		return false
+	}
+	salary := scraper.ParseSalary(job.Salary)
+	if !salary.Known() || salary.Currency == "" {
+		return false
+	}
+	low, high := salary.Monthly(settings.salary.USDToVND)
+	return high >= settings.salary.TargetMonthlyVND || low >= settings.salary.TargetMonthlyVND
+}
@@ -0,0 +1,69 @@
+package filter
+
+import (
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper"
+	"testing"
+)
+
+func configureSalary(t *testing.T, rules config.SalaryRules) {
+	t.Helper()
+	previous := settings
+	Configure(&config.Config{Salary: rules})
+	t.Cleanup(func() { settings = previous })
+}
+
+func TestShouldIncludeJob_MinSalary(t *testing.T) {
+	configureSalary(t, config.SalaryRules{MinMonthlyVND: 12_000_000})
+
+	tests := []struct {
+		salary   string
+		expected bool
+	}{
+		{salary: "15 - 25 triệu", expected: true},
+		{salary: "8 - 12 triệu", expected: true},
+		{salary: "Up to 10 triệu", expected: false},
+		{salary: "300 - 400 USD", expected: false},
+		{salary: "Up to $1,000", expected: true},
+		{salary: "Up to 1,500", expected: true},
+		{salary: "800-1200", expected: true},
+		{salary: "Từ 8 triệu", expected: true},
+		{salary: "Thỏa thuận", expected: true},
+		{salary: "", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.salary, func(t *testing.T) {
+			job := scraper.Job{Title: "Junior Golang Developer", Salary: tt.salary}
+			if got := ShouldIncludeJob(job); got != tt.expected {
+				t.Errorf("ShouldIncludeJob(salary %q) = %v, want %v", tt.salary, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculateMatchScore_TargetSalary(t *testing.T) {
+	configureSalary(t, config.SalaryRules{TargetMonthlyVND: 20_000_000})
+
+	job := scraper.Job{Title: "Junior Golang Developer", Location: "Can Tho"}
+	base := CalculateMatchScore(job)
+
+	tests := []struct {
+		salary   string
+		expected int
+	}{
+		{salary: "15 - 25 triệu", expected: base + 1},
+		{salary: "Up to $1,000", expected: base + 1},
+		{salary: "10 - 15 triệu", expected: base},
+		{salary: "Negotiable", expected: base},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.salary, func(t *testing.T) {
+			job.Salary = tt.salary
+			if got := CalculateMatchScore(job); got != tt.expected {
+				t.Errorf("CalculateMatchScore(salary %q) = %d, want %d", tt.salary, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package filter
+
+import (
+	"go-openclaw-automation/utils"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// Social posts (Threads, X, groups) are noisy: a keyword search returns tutorials,
+// "my pick" threads and candidates looking for work next to the real job posts.
+// Same regexes as execution/lib/filters.js, matched against accent-free lowercase text.
+var (
+	hiringSignalRegex       = regexp.MustCompile(`(?i)\b(we('| a)?re hiring|now hiring|is hiring|#hiring|hiring for|job opening|open position|vacancy|vacancies|recruit(ing|er)?|apply now|send (your )?(cv|resume)|jd\b|join our team|headcount|tuyen|tuyen dung|co hoi viec lam|viec lam|urgent hire|opening for|looking for)\b`)
+	roleSignalRegex         = regexp.MustCompile(`(?i)\b(golang|go\s+developer|go\s+backend|go\s+engineer|backend engineer|backend developer|software engineer|software developer|developer|engineer|intern|fresher|junior|entry[\s-]?level|trainee)\b`)
+	nonJobDiscussionRegex   = regexp.MustCompile(`(?i)\b(my pick|my take|thoughts on|thought on|roadmap|tutorial|tip[s]?|learn(?:ing)?|study|review|comparison|showcase|side project|portfolio|demo|boilerplate|template|sample code|code snippet|cheat sheet|resource[s]?|bookmark[s]?|vs\b)\b`)
+	candidateSeekingRegex   = regexp.MustCompile(`(?i)\b(open to work|looking for (a )?job|seeking (a )?(job|role|opportunit)|find(ing)? (a )?job|need a job|need work|my cv|my resume|hire me|available for work)\b`)
+	unknownLocationRegex    = regexp.MustCompile(`(?i)^\s*(unknown|n/a|na|not specified|unspecified|negotiable|multiple|various|tbd)\s*$`)
+	classifierTokenStrip    = regexp.MustCompile(`[^a-z0-9@.+#\s-]`)
+	classifierEmailRegex    = regexp.MustCompile(`(?i)@[a-z0-9.-]+\.[a-z]{2,}`)
+	classifierApplyRegex    = regexp.MustCompile(`(?i)\b(cv|resume|apply|inbox)\b`)
+	classifierSalaryRegex   = regexp.MustCompile(`(?i)\b\d{1,3}\s?(tr|m|usd|vnd|vnđ)\b`)
+	classifierLocationRegex = regexp.MustCompile(`(?i)\b(remote|hcm|ho chi minh|can tho|worldwide|global)\b`)
+	classifierGoRoleRegex   = regexp.MustCompile(`(?i)\b(golang|go backend|go developer|go engineer)\b`)
+	classifierNegativeRegex = regexp.MustCompile(`(?i)\b(open to work|my cv|hire me|my pick|tutorial|roadmap|showcase|side project)\b`)
+)
+
+// MinSocialHiringConfidence is how sure the seed classifier must be before a post
+// without an explicit hiring phrase is kept
+const MinSocialHiringConfidence = 0.72
+
+// HasHiringSignal reports an explicit hiring phrase ("we're hiring", "tuyển dụng", "send CV", ...)
+func HasHiringSignal(text string) bool {
+	return hiringSignalRegex.MatchString(utils.NormalizeText(text))
+}
+
+// HasRoleSignal reports a developer role or target level in the text
+func HasRoleSignal(text string) bool {
+	return roleSignalRegex.MatchString(utils.NormalizeText(text))
+}
+
+// LooksLikeNonJobDiscussion reports tutorials, comparisons, showcases and similar chatter
+func LooksLikeNonJobDiscussion(text string) bool {
+	return nonJobDiscussionRegex.MatchString(utils.NormalizeText(text))
+}
+
+// IsCandidateSeekingPost reports people looking for work rather than hiring
+func IsCandidateSeekingPost(text string) bool {
+	return candidateSeekingRegex.MatchString(utils.NormalizeText(text))
+}
+
+// LooksLikeSocialHiringPost is the strict heuristic: a hiring phrase plus a role,
+// and neither a candidate post nor a discussion
+func LooksLikeSocialHiringPost(text string) bool {
+	normalized := utils.NormalizeText(text)
+	if strings.TrimSpace(normalized) == "" {
+		return false
+	}
+	if IsCandidateSeekingPost(normalized) || LooksLikeNonJobDiscussion(normalized) {
+		return false
+	}
+	return HasHiringSignal(normalized) && HasRoleSignal(normalized)
+}
+
+// IsPotentialJobPost keeps posts that pass the heuristic, or that the seed classifier
+// is confident about when they still name a role (e.g. "team mình cần thêm 1 bạn Go backend")
+func IsPotentialJobPost(text string) bool {
+	if LooksLikeSocialHiringPost(text) {
+		return true
+	}
+	result := ClassifySocialHiringPost(text)
+	return result.IsHiring && result.Confidence >= MinSocialHiringConfidence && HasRoleSignal(text)
+}
+
+// HasExplicitNonPreferredLocation reports a known location that is not one we want
+// (e.g. "Đà Nẵng"); empty and placeholder values ("N/A", "Unknown") are not explicit
+func HasExplicitNonPreferredLocation(value string) bool {
+	normalized := utils.NormalizeText(value)
+	if strings.TrimSpace(normalized) == "" || unknownLocationRegex.MatchString(normalized) {
+		return false
+	}
+	return !AnalyzeLocation(normalized).HasPreferred()
+}
+
+// SocialClassification is the seed classifier's verdict on one post
+type SocialClassification struct {
+	IsHiring   bool
+	Confidence float64 //0.5 (no idea) to 1
+	Margin     float64 //log-probability difference hiring - non hiring
+}
+
+// ClassifySocialHiringPost runs the naive Bayes model trained on the seed posts
+// (port of execution/lib/local-social-classifier.js without the fastText runtime)
+func ClassifySocialHiringPost(text string) SocialClassification {
+	features := socialFeatures(text)
+	if len(features) == 0 {
+		return SocialClassification{Confidence: 0.5}
+	}
+
+	margin := seedModel.score("hiring", features) - seedModel.score("non_hiring", features)
+	return SocialClassification{
+		IsHiring:   margin > 0,
+		Confidence: 1 / (1 + math.Exp(-math.Abs(margin))),
+		Margin:     margin,
+	}
+}
+
+// socialFeatures: unigrams, bigrams and a few marker features (email, salary, location, ...)
+func socialFeatures(text string) []string {
+	normalized := utils.NormalizeText(text)
+
+	var tokens []string
+	for _, token := range strings.Fields(classifierTokenStrip.ReplaceAllString(normalized, " ")) {
+		if len(token) >= 2 {
+			tokens = append(tokens, token)
+		}
+	}
+
+	features := append([]string(nil), tokens...)
+	for i := 0; i < len(tokens)-1; i++ {
+		features = append(features, tokens[i]+"__"+tokens[i+1])
+	}
+
+	if classifierEmailRegex.MatchString(text) {
+		features = append(features, "__has_email__")
+	}
+	if classifierApplyRegex.MatchString(normalized) {
+		features = append(features, "__has_apply_signal__")
+	}
+	if classifierSalaryRegex.MatchString(normalized) {
+		features = append(features, "__has_salary__")
+	}
+	if classifierLocationRegex.MatchString(normalized) {
+		features = append(features, "__has_location__")
+	}
+	if classifierGoRoleRegex.MatchString(normalized) {
+		features = append(features, "__has_go_role__")
+	}
+	if classifierNegativeRegex.MatchString(normalized) {
+		features = append(features, "__negative_pattern__")
+	}
+	return features
+}
+
+type socialModel struct {
+	docCounts      map[string]int
+	tokenTotals    map[string]int
+	tokenCounts    map[string]map[string]int
+	vocabularySize int
+	totalDocs      int
+}
+
+var seedModel = buildSocialModel(map[string][]string{
+	"hiring":     socialSeedsPositive,
+	"non_hiring": socialSeedsNegative,
+})
+
+func buildSocialModel(classDocs map[string][]string) *socialModel {
+	m := &socialModel{
+		docCounts:   make(map[string]int),
+		tokenTotals: make(map[string]int),
+		tokenCounts: make(map[string]map[string]int),
+	}
+	vocabulary := make(map[string]bool)
+
+	for label, docs := range classDocs {
+		m.docCounts[label] = len(docs)
+		m.totalDocs += len(docs)
+		m.tokenCounts[label] = make(map[string]int)
+		for _, doc := range docs {
+			for _, feature := range socialFeatures(doc) {
+				vocabulary[feature] = true
+				m.tokenTotals[label]++
+				m.tokenCounts[label][feature]++
+			}
+		}
+	}
+	m.vocabularySize = len(vocabulary)
+	return m
+}
+
+// score is the log prior plus Laplace-smoothed log likelihood of every feature
+func (m *socialModel) score(label string, features []string) float64 {
+	score := math.Log(float64(m.docCounts[label]) / float64(m.totalDocs))
+	denom := float64(m.tokenTotals[label] + m.vocabularySize)
+	for _, feature := range features {
+		score += math.Log(float64(m.tokenCounts[label][feature]+1) / denom)
+	}
+	return score
+}
+
+// Seed posts, same as execution/models/social-hiring-seeds.js
+var socialSeedsPositive = []string{
+	"We are hiring a Junior Golang Developer. Remote. Send CV to jobs@example.com.",
+	"Tuyen dung Go backend intern, lam viec tai HCM, CV gui email hr@company.com.",
+	"Hiring Golang fresher for product team, salary 20-30tr, apply now.",
+	"Can 2 ban Go backend junior, CV inbox hoac gui resume qua mail.",
+	"Job opening: Go engineer intern, location Can Tho, phuc loi day du.",
+	"Join our team as a Golang backend developer. Hybrid in HCM.",
+	"Urgent hire Go developer. Remote work. Resume to talent@startup.io.",
+	"Cong ty dang mo vi tri Golang fresher, lam viec tu xa, inbox CV.",
+	"Looking for a Go backend engineer intern for our fintech platform.",
+	"Open position for Junior Golang engineer. Salary up to 1500 USD.",
+	"Tuyen dung backend Go junior, location Can Tho, cv ve hr@abc.vn.",
+	"Team minh can them 1 ban go backend intern, cv inbox giup minh.",
+	"Recruiting Go developer trainee, work from home, send your CV today.",
+	"Vacancy: Golang fresher for SaaS product, remote within Vietnam.",
+	"Hiring for Go backend fresher role, PostgreSQL va Docker la diem cong.",
+}
+
+var socialSeedsNegative = []string{
+	"SwiftUI x golang my pick",
+	"Open to work Golang backend developer, here is my CV.",
+	"Golang roadmap for 2026, save this post.",
+	"My take on Go vs Rust for backend systems.",
+	"Portfolio update: built my side project with golang and React.",
+	"Looking for a job as Golang intern, please review my resume.",
+	"Tutorial: build REST API with Go in 20 minutes.",
+	"Showcase: my boilerplate for Go microservices.",
+	"Golang resource list and study notes.",
+	"Thoughts on using Go for CLI apps?",
+	"Bookmark this: awesome Go libraries list.",
+	"I need a remote Golang job, hire me please.",
+	"Comparison between SwiftUI and golang for my learning path.",
+	"Review of my first Go backend side project.",
+	"Sample code: GraphQL server in Go.",
+}
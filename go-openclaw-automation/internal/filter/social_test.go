@@ -0,0 +1,94 @@
+package filter
+
+import "testing"
+
+// Same sample posts as testing/test-threads-heuristics.js
+const (
+	realJobPost = `
+        We are hiring a Junior Golang Developer
+        Location: Remote
+        Salary: 25-35tr
+        Send CV to jobs@example.com
+    `
+	noisyPost        = "SwiftUI x golang my pick"
+	candidatePost    = "Open to work Golang backend developer intern, here is my CV"
+	weirdButRealPost = "Team minh can them 1 ban Go backend intern, CV inbox giup minh."
+)
+
+func TestLooksLikeSocialHiringPost(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "real job post", text: realJobPost, want: true},
+		{name: "non-job discussion", text: noisyPost, want: false},
+		{name: "candidate seeking", text: candidatePost, want: false},
+		{name: "vietnamese with accents", text: "Công ty mình đang tuyển dụng Golang fresher, gửi CV qua inbox", want: true},
+		{name: "empty", text: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LooksLikeSocialHiringPost(tt.text); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsPotentialJobPost(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "real job post", text: realJobPost, want: true},
+		{name: "non-job discussion", text: noisyPost, want: false},
+		{name: "non-template hiring post", text: weirdButRealPost, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPotentialJobPost(tt.text); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifySocialHiringPost(t *testing.T) {
+	result := ClassifySocialHiringPost(weirdButRealPost)
+	if !result.IsHiring {
+		t.Errorf("expected the classifier to recognize a non-template hiring post, margin %.2f", result.Margin)
+	}
+
+	if result := ClassifySocialHiringPost(noisyPost); result.IsHiring {
+		t.Errorf("expected the classifier to reject a non-job discussion, margin %.2f", result.Margin)
+	}
+
+	if result := ClassifySocialHiringPost("!!"); result.IsHiring || result.Confidence != 0.5 {
+		t.Errorf("expected no opinion without features, got %+v", result)
+	}
+}
+
+func TestHasExplicitNonPreferredLocation(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{"Đà Nẵng", true},
+		{"Singapore", true},
+		{"Remote", false},
+		{"TP. Hồ Chí Minh", false},
+		{"N/A", false},
+		{"Unknown", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := HasExplicitNonPreferredLocation(tt.value); got != tt.want {
+			t.Errorf("%q: got %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
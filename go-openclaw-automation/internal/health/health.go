@@ -0,0 +1,217 @@
+// Platform health tracker
+// Port of execution/openclaw/health.js: counts blocked and failed runs per platform in a
+// state file, and opens a circuit after repeated blocks so the next runs skip the platform
+// for a cooldown that doubles every time it reopens
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/utils"
+	"os"
+	"sync"
+	"time"
+)
+
+// Outcome is how a scraper run ended
+type Outcome string
+
+const (
+	OutcomeOK      Outcome = "ok"      //finished with jobs
+	OutcomeEmpty   Outcome = "empty"   //finished without jobs
+	OutcomeBlocked Outcome = "blocked" //anti-bot challenge or login wall
+	OutcomeFailed  Outcome = "failed"
+	OutcomeTimeout Outcome = "timeout"
+	OutcomePartial Outcome = "partial" //timed out after streaming jobs, a good run
+)
+
+// OutcomeOf classifies a run from the scraper's error and the number of jobs it sent.
+// A login wall counts as blocked: retrying is pointless until the cookies are refreshed.
+// A timeout after jobs were sent is partial: the streamed jobs are kept, nothing failed.
+func OutcomeOf(err error, jobs int) Outcome {
+	switch {
+	case err == nil && jobs > 0:
+		return OutcomeOK
+	case err == nil:
+		return OutcomeEmpty
+	case errors.Is(err, browser.ErrBlocked), errors.Is(err, browser.ErrLoginRequired):
+		return OutcomeBlocked
+	case errors.Is(err, context.DeadlineExceeded) && jobs > 0:
+		return OutcomePartial
+	case errors.Is(err, context.DeadlineExceeded):
+		return OutcomeTimeout
+	default:
+		return OutcomeFailed
+	}
+}
+
+// PlatformHealth is the persisted state of one platform
+type PlatformHealth struct {
+	ConsecutiveBlocked int     `json:"consecutive_blocked"`
+	ConsecutiveFailed  int     `json:"consecutive_failed"` //failed or timed out
+	LastOutcome        Outcome `json:"last_outcome"`
+	LastError          string  `json:"last_error,omitempty"`
+	//Trips counts the circuit openings since the last good run, the cooldown doubles with each
+	Trips     int       `json:"trips"`
+	OpenUntil time.Time `json:"open_until,omitzero"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Options tunes a Tracker; zero values take the defaults
+type Options struct {
+	BlockedThreshold int           //consecutive blocked runs that open the circuit (default 3)
+	FailedThreshold  int           //consecutive failed runs that raise an alert (default 3)
+	Cooldown         time.Duration //first opening (default 1h)
+	MaxCooldown      time.Duration //cap of the doubled cooldown (default 24h)
+}
+
+// Tracker records run outcomes per platform. Safe for concurrent use.
+type Tracker struct {
+	path string
+	opts Options
+	now  func() time.Time
+
+	mu        sync.Mutex
+	platforms map[string]*PlatformHealth
+	alerts    []string
+}
+
+// stateFile is the JSON layout, the same shape as the Node platform-health.json
+type stateFile struct {
+	Platforms map[string]*PlatformHealth `json:"platforms"`
+}
+
+// Load reads the tracker state from path; a missing file starts with every platform healthy
+func Load(path string, opts Options) (*Tracker, error) {
+	if opts.BlockedThreshold <= 0 {
+		opts.BlockedThreshold = 3
+	}
+	if opts.FailedThreshold <= 0 {
+		opts.FailedThreshold = 3
+	}
+	if opts.Cooldown <= 0 {
+		opts.Cooldown = time.Hour
+	}
+	if opts.MaxCooldown <= 0 {
+		opts.MaxCooldown = 24 * time.Hour
+	}
+
+	t := &Tracker{path: path, opts: opts, now: time.Now, platforms: map[string]*PlatformHealth{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return t, nil
+	}
+	if err != nil {
+		return t, fmt.Errorf("failed to read platform health: %w", err)
+	}
+	var state stateFile
+	if err := json.Unmarshal(data, &state); err != nil {
+		return t, fmt.Errorf("failed to parse platform health %s: %w", path, err)
+	}
+	for name, platform := range state.Platforms {
+		if platform != nil {
+			t.platforms[name] = platform
+		}
+	}
+	return t, nil
+}
+
+// Allow reports whether platform may run now; when it may not, it returns when the circuit closes
+func (t *Tracker) Allow(platform string) (bool, time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p, ok := t.platforms[platform]
+	if !ok || !t.now().Before(p.OpenUntil) {
+		return true, time.Time{}
+	}
+	return false, p.OpenUntil
+}
+
+// Record stores the outcome of a run (err may be nil). A blocked run at or past the threshold
+// opens the circuit; after the cooldown one trial run is allowed, and blocking it again
+// reopens the circuit for twice as long. A good run closes it.
+func (t *Tracker) Record(platform string, outcome Outcome, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.platforms[platform]
+	if !ok {
+		p = &PlatformHealth{}
+		t.platforms[platform] = p
+	}
+	p.LastOutcome = outcome
+	p.LastError = ""
+	if err != nil {
+		p.LastError = err.Error()
+	}
+	p.UpdatedAt = t.now()
+
+	switch outcome {
+	case OutcomeBlocked:
+		p.ConsecutiveBlocked++
+		p.ConsecutiveFailed = 0
+		if p.ConsecutiveBlocked >= t.opts.BlockedThreshold {
+			cooldown := t.cooldown(p.Trips)
+			p.Trips++
+			p.OpenUntil = p.UpdatedAt.Add(cooldown)
+			t.alerts = append(t.alerts, fmt.Sprintf("%s has been blocked for %d consecutive runs, skipping it for %v.", platform, p.ConsecutiveBlocked, cooldown))
+		}
+	case OutcomeFailed, OutcomeTimeout:
+		p.ConsecutiveFailed++
+		p.ConsecutiveBlocked = 0
+		//alert once per streak
+		if p.ConsecutiveFailed == t.opts.FailedThreshold {
+			t.alerts = append(t.alerts, fmt.Sprintf("%s has failed for %d consecutive runs.", platform, p.ConsecutiveFailed))
+		}
+	default:
+		p.ConsecutiveBlocked = 0
+		p.ConsecutiveFailed = 0
+		p.Trips = 0
+		p.OpenUntil = time.Time{}
+	}
+}
+
+// cooldown doubles the base cooldown for every previous trip, up to MaxCooldown
+func (t *Tracker) cooldown(trips int) time.Duration {
+	cooldown := t.opts.Cooldown
+	for i := 0; i < trips && cooldown < t.opts.MaxCooldown; i++ {
+		cooldown *= 2
+	}
+	return min(cooldown, t.opts.MaxCooldown)
+}
+
+// Platform returns a copy of a platform's state (zero value if it never ran)
+func (t *Tracker) Platform(platform string) PlatformHealth {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if p, ok := t.platforms[platform]; ok {
+		return *p
+	}
+	return PlatformHealth{}
+}
+
+// Alerts returns the alerts raised by Record since the tracker was loaded
+func (t *Tracker) Alerts() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string(nil), t.alerts...)
+}
+
+// Save writes the state file atomically
+func (t *Tracker) Save() error {
+	t.mu.Lock()
+	data, err := json.MarshalIndent(stateFile{Platforms: t.platforms}, "", "  ")
+	t.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode platform health: %w", err)
+	}
+
+	if err := utils.WriteFileAtomic(t.path, data); err != nil {
+		return fmt.Errorf("failed to save platform health: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-openclaw-automation/internal/browser"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutcomeOf(t *testing.T) {
+	blocked := &browser.ChallengeError{Platform: "topcv", Kind: browser.ChallengeCloudflare, URL: "https://www.topcv.vn/"}
+
+	assert.Equal(t, OutcomeOK, OutcomeOf(nil, 3))
+	assert.Equal(t, OutcomeEmpty, OutcomeOf(nil, 0))
+	assert.Equal(t, OutcomeBlocked, OutcomeOf(fmt.Errorf("topcv: search %q: %w", "golang", blocked), 2))
+	assert.Equal(t, OutcomeBlocked, OutcomeOf(fmt.Errorf("twitter: login wall: %w", browser.ErrLoginRequired), 0))
+	assert.Equal(t, OutcomeTimeout, OutcomeOf(fmt.Errorf("itviec: %w", context.DeadlineExceeded), 0))
+	assert.Equal(t, OutcomePartial, OutcomeOf(fmt.Errorf("topcv: %w", context.DeadlineExceeded), 12))
+	assert.Equal(t, OutcomeFailed, OutcomeOf(errors.New("itviec: failed to create page"), 0))
+}
+
+func TestTracker_CircuitBreaker(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	tracker, err := Load(filepath.Join(t.TempDir(), "platform-health.json"), Options{BlockedThreshold: 2, Cooldown: time.Hour, MaxCooldown: 3 * time.Hour})
+	require.NoError(t, err)
+	tracker.now = func() time.Time { return now }
+
+	//below the threshold the platform keeps running
+	tracker.Record("topcv", OutcomeBlocked, browser.ErrBlocked)
+	allowed, _ := tracker.Allow("topcv")
+	assert.True(t, allowed)
+
+	//the threshold opens the circuit for the base cooldown
+	tracker.Record("topcv", OutcomeBlocked, browser.ErrBlocked)
+	allowed, until := tracker.Allow("topcv")
+	assert.False(t, allowed)
+	assert.Equal(t, now.Add(time.Hour), until)
+	assert.Len(t, tracker.Alerts(), 1)
+
+	//other platforms are not affected
+	allowed, _ = tracker.Allow("itviec")
+	assert.True(t, allowed)
+
+	//after the cooldown one trial run; blocked again doubles the cooldown, up to the cap
+	now = now.Add(time.Hour)
+	allowed, _ = tracker.Allow("topcv")
+	assert.True(t, allowed)
+	tracker.Record("topcv", OutcomeBlocked, browser.ErrBlocked)
+	_, until = tracker.Allow("topcv")
+	assert.Equal(t, now.Add(2*time.Hour), until)
+
+	now = now.Add(2 * time.Hour)
+	tracker.Record("topcv", OutcomeBlocked, browser.ErrBlocked)
+	_, until = tracker.Allow("topcv")
+	assert.Equal(t, now.Add(3*time.Hour), until)
+
+	//a good run closes the circuit and resets the backoff
+	now = now.Add(3 * time.Hour)
+	tracker.Record("topcv", OutcomeEmpty, nil)
+	allowed, _ = tracker.Allow("topcv")
+	assert.True(t, allowed)
+	assert.Equal(t, PlatformHealth{LastOutcome: OutcomeEmpty, UpdatedAt: now}, tracker.Platform("topcv"))
+}
+
+func TestTracker_FailedRunsAlertOnce(t *testing.T) {
+	tracker, err := Load(filepath.Join(t.TempDir(), "platform-health.json"), Options{FailedThreshold: 2})
+	require.NoError(t, err)
+
+	tracker.Record("itviec", OutcomeFailed, errors.New("navigation failed"))
+	tracker.Record("itviec", OutcomeTimeout, context.DeadlineExceeded)
+	tracker.Record("itviec", OutcomeFailed, errors.New("navigation failed"))
+
+	assert.Equal(t, []string{"itviec has failed for 2 consecutive runs."}, tracker.Alerts())
+	assert.Equal(t, 3, tracker.Platform("itviec").ConsecutiveFailed)
+	//failures never open the circuit
+	allowed, _ := tracker.Allow("itviec")
+	assert.True(t, allowed)
+}
+
+func TestTracker_PartialRunResetsFailures(t *testing.T) {
+	tracker, err := Load(filepath.Join(t.TempDir(), "platform-health.json"), Options{FailedThreshold: 2})
+	require.NoError(t, err)
+
+	tracker.Record("topcv", OutcomeTimeout, context.DeadlineExceeded)
+	tracker.Record("topcv", OutcomeOf(context.DeadlineExceeded, 5), context.DeadlineExceeded)
+	tracker.Record("topcv", OutcomeTimeout, context.DeadlineExceeded)
+
+	assert.Empty(t, tracker.Alerts(), "a timeout after streaming jobs does not count toward the failed threshold")
+	assert.Equal(t, 1, tracker.Platform("topcv").ConsecutiveFailed)
+}
+
+func TestTracker_Persists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "platform-health.json")
+	tracker, err := Load(path, Options{BlockedThreshold: 1})
+	require.NoError(t, err)
+	tracker.Record("indeed", OutcomeBlocked, browser.ErrBlocked)
+	require.NoError(t, tracker.Save())
+
+	reloaded, err := Load(path, Options{BlockedThreshold: 1})
+	require.NoError(t, err)
+	allowed, _ := reloaded.Allow("indeed")
+	assert.False(t, allowed)
+	assert.Equal(t, OutcomeBlocked, reloaded.Platform("indeed").LastOutcome)
+	assert.Equal(t, "blocked by anti-bot challenge", reloaded.Platform("indeed").LastError)
+}
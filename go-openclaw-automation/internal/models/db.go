@@ -31,6 +31,11 @@ type Job struct {
 	URL                string    `json:"url"`
 	Location           string    `json:"location"`
 	Salary             string    `json:"salary"`
+	SalaryMin          *int64    `json:"salary_min,omitempty"` // nil when open or unknown
+	SalaryMax          *int64    `json:"salary_max,omitempty"`
+	SalaryCurrency     string    `json:"salary_currency"` // VND, USD or empty
+	SalaryPeriod       string    `json:"salary_period"`   // hour, day, week, month, year
+	SalaryNegotiable   bool      `json:"salary_negotiable"`
 	MatchScore         int       `json:"match_score"`
 	PostedAt           string    `json:"posted_at"`
 	DescriptionRaw     string    `json:"description_raw"`
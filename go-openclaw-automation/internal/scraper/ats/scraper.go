@@ -0,0 +1,136 @@
+// Company career boards (Greenhouse, Lever, Ashby)
+// No browser needed: every ATS publishes its board as public JSON
+
+package ats
+
+import (
+	"context"
+	"fmt"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/filter"
+	"go-openclaw-automation/internal/scraper"
+	"log"
+	"strings"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// provider reads the board JSON of one ATS
+type provider struct {
+	Name string //shown as the job source
+	//Endpoint is the board API URL, %s is the board token
+	Endpoint string
+	Parse    func(data []byte, board config.ATSBoard) ([]scraper.Job, error)
+}
+
+// providers are keyed by the `ats` value of a board entry
+var providers = map[string]provider{
+	"greenhouse": {Name: "Greenhouse", Endpoint: "https://boards-api.greenhouse.io/v1/boards/%s/jobs?content=true", Parse: parseGreenhouse},
+	"lever":      {Name: "Lever", Endpoint: "https://api.lever.co/v0/postings/%s?mode=json", Parse: parseLever},
+	"ashby":      {Name: "Ashby", Endpoint: "https://api.ashbyhq.com/posting-api/job-board/%s?includeCompensation=true", Parse: parseAshby},
+}
+
+func init() {
+	scraper.Register("ats", func(cfg *config.Config) scraper.Scraper {
+		return NewATSScraper(cfg)
+	})
+}
+
+type ATSScraper struct {
+	cfg       *config.Config
+	client    *browser.HTTPClient
+	providers map[string]provider
+}
+
+func NewATSScraper(cfg *config.Config) *ATSScraper {
+	return &ATSScraper{
+		cfg:       cfg,
+		client:    browser.NewHTTPClient(0),
+		providers: providers,
+	}
+}
+
+// NeedsBrowser is false: board APIs are plain JSON
+func (s *ATSScraper) NeedsBrowser() bool {
+	return false
+}
+
+// SetHTTPClient shares the runner's cookie jar and response cache
+func (s *ATSScraper) SetHTTPClient(client *browser.HTTPClient) {
+	s.client = client
+}
+
+func (s *ATSScraper) Name() string {
+	return "ATS"
+}
+
+// Scrape reads every configured board; browserCtx is unused
+func (s *ATSScraper) Scrape(ctx context.Context, browserCtx playwright.BrowserContext) ([]scraper.Job, error) {
+	return scraper.Collect(ctx, s, browserCtx)
+}
+
+// ScrapeStream sends the matching jobs of each board as soon as that board is read.
+// A broken or unknown board is logged and skipped so one company does not hide the others.
+func (s *ATSScraper) ScrapeStream(ctx context.Context, browserCtx playwright.BrowserContext, out chan<- scraper.Job) error {
+	log.Printf("🏢 Reading %d company career boards...", len(s.cfg.ATSBoards))
+	seenURLs := make(map[string]bool)
+
+	for _, board := range s.cfg.ATSBoards {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		jobs, err := s.fetch(ctx, board)
+		if err != nil {
+			log.Printf("  ⚠️ %s (%s): %v", board.Company, board.ATS, err)
+			continue
+		}
+
+		matched := 0
+		for _, job := range jobs {
+			if job.URL == "" || seenURLs[job.URL] || !s.matches(job) {
+				continue
+			}
+			seenURLs[job.URL] = true
+			matched++
+			if err := scraper.Send(ctx, out, job); err != nil {
+				return err
+			}
+		}
+		log.Printf("  📦 %s: %d open positions, %d matching", board.Company, len(jobs), matched)
+	}
+	return nil
+}
+
+func (s *ATSScraper) fetch(ctx context.Context, board config.ATSBoard) ([]scraper.Job, error) {
+	p, ok := s.providers[strings.ToLower(board.ATS)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported ats %q (want greenhouse, lever or ashby)", board.ATS)
+	}
+	if board.BoardToken == "" {
+		return nil, fmt.Errorf("board_token is empty")
+	}
+
+	data, err := s.client.Get(ctx, fmt.Sprintf(p.Endpoint, board.BoardToken))
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := p.Parse(data, board)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s board: %w", p.Name, err)
+	}
+	for i := range jobs {
+		jobs[i].Source = p.Name
+	}
+	return jobs, nil
+}
+
+// matches keeps jobs that mention a configured keyword and are not only in a city we
+// do not want (boards list every office; remote and unknown locations are kept)
+func (s *ATSScraper) matches(job scraper.Job) bool {
+	if filter.HasExplicitNonPreferredLocation(job.Location) || filter.AnalyzeLocation(job.Location).HanoiOnly() {
+		return false
+	}
+	return mentionsKeyword(job.Title+" "+job.Description, s.cfg.Keywords)
+}
@@ -0,0 +1,138 @@
+package ats
+
+import (
+	"context"
+	"go-openclaw-automation/internal/config"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	require.NoError(t, err)
+	return data
+}
+
+func TestParseGreenhouse(t *testing.T) {
+	jobs, err := parseGreenhouse(readFixture(t, "greenhouse.json"), config.ATSBoard{Company: "Vercel", ATS: "greenhouse", BoardToken: "vercel"})
+	require.NoError(t, err)
+	require.Len(t, jobs, 3)
+
+	job := jobs[0]
+	assert.Equal(t, "Software Engineer, Golang Platform", job.Title)
+	assert.Equal(t, "Vercel", job.Company)
+	assert.Equal(t, "https://boards.greenhouse.io/vercel/jobs/5012345004", job.URL)
+	assert.Equal(t, "Remote - Americas", job.Location)
+	assert.Equal(t, "2026-10-01", job.PostedDate, "first_published wins over updated_at")
+	assert.Equal(t, "Build the edge runtime in Golang.\ngRPC & Kubernetes", job.Description)
+
+	assert.Equal(t, "2026-10-11", jobs[1].PostedDate, "updated_at when never published")
+}
+
+func TestParseLever(t *testing.T) {
+	jobs, err := parseLever(readFixture(t, "lever.json"), config.ATSBoard{Company: "Gopher Co", ATS: "lever", BoardToken: "gopherco"})
+	require.NoError(t, err)
+	require.Len(t, jobs, 3)
+
+	job := jobs[0]
+	assert.Equal(t, "Golang Intern", job.Title)
+	assert.Equal(t, "Gopher Co", job.Company)
+	assert.Equal(t, "https://jobs.lever.co/gopherco/8f1c2d3e-0000-4a5b-9c8d-000000000001", job.URL)
+	assert.Equal(t, "Ho Chi Minh City", job.Location)
+	assert.Equal(t, "8000000 - 12000000 VND/month", job.Salary)
+	assert.Equal(t, "2025-10-09", job.PostedDate)
+	assert.Equal(t, "Learn Golang with our backend team.\n\nWhat you will do\n\nWrite Go services\nReview pull requests\n\nLaptop provided.", job.Description)
+
+	assert.Equal(t, "Negotiable", jobs[1].Salary)
+	assert.Equal(t, "Da Nang; Remote", jobs[2].Location, "remote workplace type is added to the location")
+}
+
+func TestParseAshby(t *testing.T) {
+	jobs, err := parseAshby(readFixture(t, "ashby.json"), config.ATSBoard{ATS: "ashby", BoardToken: "acme"})
+	require.NoError(t, err)
+	require.Len(t, jobs, 2, "unlisted postings are skipped")
+
+	job := jobs[0]
+	assert.Equal(t, "Junior Backend Engineer", job.Title)
+	assert.Equal(t, "acme", job.Company, "board token when config has no company name")
+	assert.Equal(t, "Singapore; Ho Chi Minh City", job.Location)
+	assert.Equal(t, "$2K – $3K • Offers Equity", job.Salary)
+	assert.Equal(t, "2026-10-08", job.PostedDate)
+	assert.Equal(t, "Build APIs in Golang.\nPostgres and Redis.", job.Description)
+
+	assert.Equal(t, "Berlin; Remote", jobs[1].Location)
+	assert.Equal(t, "Remote within Europe, Golang & Kafka.", jobs[1].Description, "HTML description when there is no plain one")
+}
+
+func TestParse_InvalidJSON(t *testing.T) {
+	for name, p := range providers {
+		_, err := p.Parse([]byte(`<html>Not found</html>`), config.ATSBoard{})
+		assert.Error(t, err, name)
+	}
+}
+
+// TestATSScraper_Scrape serves the recorded boards from a local server.
+// Jobs in a city we do not want, or without the keyword, are dropped; a broken board
+// and an unsupported ATS are skipped without failing the run.
+func TestATSScraper_Scrape(t *testing.T) {
+	fixtures := map[string]string{
+		"/greenhouse/vercel": "greenhouse.json",
+		"/lever/gopherco":    "lever.json",
+		"/ashby/acme":        "ashby.json",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name, ok := fixtures[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(readFixture(t, name))
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{
+		Keywords: []string{"golang"},
+		ATSBoards: []config.ATSBoard{
+			{Company: "Vercel", ATS: "greenhouse", BoardToken: "vercel"},
+			{Company: "Gopher Co", ATS: "Lever", BoardToken: "gopherco"},
+			{Company: "Acme", ATS: "ashby", BoardToken: "acme"},
+			{Company: "Gone", ATS: "greenhouse", BoardToken: "gone"},
+			{Company: "Custom", ATS: "workday", BoardToken: "custom"},
+		},
+	}
+	s := NewATSScraper(cfg)
+	s.providers = make(map[string]provider, len(providers))
+	for name, p := range providers {
+		p.Endpoint = server.URL + "/" + name + "/%s"
+		s.providers[name] = p
+	}
+
+	jobs, err := s.Scrape(context.Background(), nil)
+	require.NoError(t, err)
+
+	var titles []string
+	for _, job := range jobs {
+		titles = append(titles, job.Source+": "+job.Title)
+	}
+	assert.Equal(t, []string{
+		"Greenhouse: Software Engineer, Golang Platform",
+		"Lever: Golang Intern",
+		"Lever: Go Backend Engineer",
+		"Ashby: Junior Backend Engineer",
+		"Ashby: Golang Engineer",
+	}, titles, strings.Join(titles, "\n"))
+}
+
+func TestMentionsKeyword(t *testing.T) {
+	assert.True(t, mentionsKeyword("Backend Engineer (GoLang)", []string{"golang"}))
+	assert.True(t, mentionsKeyword("Lập trình viên Golang", []string{"lập trình"}))
+	assert.False(t, mentionsKeyword("Design Engineer", []string{"golang", " "}))
+}
@@ -0,0 +1,284 @@
+package ats
+
+import (
+	"encoding/json"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper"
+	"go-openclaw-automation/utils"
+	"html"
+	"strings"
+	"time"
+)
+
+// maxDescriptionChars matches the detail-page scrapers
+const maxDescriptionChars = 5000
+
+// greenhouseBoard is GET boards-api.greenhouse.io/v1/boards/<token>/jobs?content=true
+type greenhouseBoard struct {
+	Jobs []struct {
+		Title          string `json:"title"`
+		AbsoluteURL    string `json:"absolute_url"`
+		CompanyName    string `json:"company_name"`
+		UpdatedAt      string `json:"updated_at"`
+		FirstPublished string `json:"first_published"`
+		Content        string `json:"content"` //HTML, entity-escaped once more
+		Location       struct {
+			Name string `json:"name"`
+		} `json:"location"`
+	} `json:"jobs"`
+}
+
+func parseGreenhouse(data []byte, board config.ATSBoard) ([]scraper.Job, error) {
+	var payload greenhouseBoard
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	jobs := make([]scraper.Job, 0, len(payload.Jobs))
+	for _, j := range payload.Jobs {
+		published := j.FirstPublished
+		if published == "" {
+			published = j.UpdatedAt
+		}
+		jobs = append(jobs, scraper.Job{
+			Title:       cleanText(j.Title),
+			Company:     companyName(board, j.CompanyName),
+			URL:         j.AbsoluteURL,
+			Location:    cleanText(j.Location.Name),
+			Salary:      "Negotiable",
+			Techstack:   "Golang",
+			Description: description(scraper.HTMLToText(html.UnescapeString(j.Content))),
+			PostedDate:  isoDate(published),
+		})
+	}
+	return jobs, nil
+}
+
+// leverPosting is one item of GET api.lever.co/v0/postings/<token>?mode=json
+type leverPosting struct {
+	Text       string `json:"text"`
+	HostedURL  string `json:"hostedUrl"`
+	CreatedAt  int64  `json:"createdAt"` //unix ms
+	Categories struct {
+		Location     string   `json:"location"`
+		AllLocations []string `json:"allLocations"`
+	} `json:"categories"`
+	WorkplaceType    string `json:"workplaceType"` //onsite, remote, hybrid
+	DescriptionPlain string `json:"descriptionPlain"`
+	Lists            []struct {
+		Text    string `json:"text"`
+		Content string `json:"content"` //HTML <li> items
+	} `json:"lists"`
+	AdditionalPlain string `json:"additionalPlain"`
+	SalaryRange     *struct {
+		Currency string  `json:"currency"`
+		Interval string  `json:"interval"` //per-year-salary, per-month-salary, per-hour-wage
+		Min      float64 `json:"min"`
+		Max      float64 `json:"max"`
+	} `json:"salaryRange"`
+}
+
+func parseLever(data []byte, board config.ATSBoard) ([]scraper.Job, error) {
+	var payload []leverPosting
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	jobs := make([]scraper.Job, 0, len(payload))
+	for _, p := range payload {
+		locations := p.Categories.AllLocations
+		if len(locations) == 0 && p.Categories.Location != "" {
+			locations = []string{p.Categories.Location}
+		}
+		if strings.EqualFold(p.WorkplaceType, "remote") {
+			locations = appendRemote(locations)
+		}
+
+		parts := []string{p.DescriptionPlain}
+		for _, list := range p.Lists {
+			parts = append(parts, list.Text, scraper.HTMLToText(list.Content))
+		}
+		parts = append(parts, p.AdditionalPlain)
+
+		salary := "Negotiable"
+		if p.SalaryRange != nil {
+			posting := scraper.JobPosting{
+				SalaryMin:      p.SalaryRange.Min,
+				SalaryMax:      p.SalaryRange.Max,
+				SalaryCurrency: p.SalaryRange.Currency,
+				SalaryUnit:     leverSalaryUnit(p.SalaryRange.Interval),
+			}
+			if text := posting.SalaryText(); text != "" {
+				salary = text
+			}
+		}
+
+		postedDate := "N/A"
+		if p.CreatedAt > 0 {
+			postedDate = time.UnixMilli(p.CreatedAt).UTC().Format("2006-01-02")
+		}
+
+		jobs = append(jobs, scraper.Job{
+			Title:       cleanText(p.Text),
+			Company:     companyName(board, ""),
+			URL:         p.HostedURL,
+			Location:    strings.Join(locations, "; "),
+			Salary:      salary,
+			Techstack:   "Golang",
+			Description: description(joinParagraphs(parts)),
+			PostedDate:  postedDate,
+		})
+	}
+	return jobs, nil
+}
+
+// ashbyBoard is GET api.ashbyhq.com/posting-api/job-board/<token>?includeCompensation=true
+type ashbyBoard struct {
+	Jobs []struct {
+		Title              string `json:"title"`
+		Location           string `json:"location"`
+		SecondaryLocations []struct {
+			Location string `json:"location"`
+		} `json:"secondaryLocations"`
+		IsRemote         bool   `json:"isRemote"`
+		IsListed         *bool  `json:"isListed"`
+		PublishedAt      string `json:"publishedAt"`
+		JobURL           string `json:"jobUrl"`
+		DescriptionPlain string `json:"descriptionPlain"`
+		DescriptionHTML  string `json:"descriptionHtml"`
+		Compensation     *struct {
+			Summary string `json:"compensationTierSummary"`
+		} `json:"compensation"`
+	} `json:"jobs"`
+}
+
+func parseAshby(data []byte, board config.ATSBoard) ([]scraper.Job, error) {
+	var payload ashbyBoard
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	jobs := make([]scraper.Job, 0, len(payload.Jobs))
+	for _, j := range payload.Jobs {
+		//unlisted postings are reachable by link only, the company does not advertise them
+		if j.IsListed != nil && !*j.IsListed {
+			continue
+		}
+
+		var locations []string
+		if j.Location != "" {
+			locations = append(locations, j.Location)
+		}
+		for _, secondary := range j.SecondaryLocations {
+			if secondary.Location != "" {
+				locations = append(locations, secondary.Location)
+			}
+		}
+		if j.IsRemote {
+			locations = appendRemote(locations)
+		}
+
+		text := j.DescriptionPlain
+		if text == "" {
+			text = scraper.HTMLToText(j.DescriptionHTML)
+		}
+
+		salary := "Negotiable"
+		if j.Compensation != nil && strings.TrimSpace(j.Compensation.Summary) != "" {
+			salary = cleanText(j.Compensation.Summary)
+		}
+
+		jobs = append(jobs, scraper.Job{
+			Title:       cleanText(j.Title),
+			Company:     companyName(board, ""),
+			URL:         j.JobURL,
+			Location:    strings.Join(locations, "; "),
+			Salary:      salary,
+			Techstack:   "Golang",
+			Description: description(text),
+			PostedDate:  isoDate(j.PublishedAt),
+		})
+	}
+	return jobs, nil
+}
+
+// mentionsKeyword reports whether text contains one of the keywords (case and accents ignored)
+func mentionsKeyword(text string, keywords []string) bool {
+	normalized := utils.NormalizeText(text)
+	for _, keyword := range keywords {
+		if keyword = utils.NormalizeText(strings.TrimSpace(keyword)); keyword != "" && strings.Contains(normalized, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// companyName prefers the name from config, the board's own name otherwise
+func companyName(board config.ATSBoard, fromBoard string) string {
+	if board.Company != "" {
+		return board.Company
+	}
+	if fromBoard != "" {
+		return fromBoard
+	}
+	return board.BoardToken
+}
+
+// appendRemote adds "Remote" unless a location already says so
+func appendRemote(locations []string) []string {
+	for _, loc := range locations {
+		if strings.Contains(strings.ToLower(loc), "remote") {
+			return locations
+		}
+	}
+	return append(locations, "Remote")
+}
+
+func leverSalaryUnit(interval string) string {
+	switch {
+	case strings.Contains(interval, "year"):
+		return "YEAR"
+	case strings.Contains(interval, "month"):
+		return "MONTH"
+	case strings.Contains(interval, "week"):
+		return "WEEK"
+	case strings.Contains(interval, "day"):
+		return "DAY"
+	case strings.Contains(interval, "hour"):
+		return "HOUR"
+	}
+	return ""
+}
+
+// isoDate turns an RFC 3339 timestamp into YYYY-MM-DD ("N/A" when absent or unreadable)
+func isoDate(s string) string {
+	s = strings.TrimSpace(s)
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.Format("2006-01-02")
+		}
+	}
+	return "N/A"
+}
+
+func joinParagraphs(parts []string) string {
+	var kept []string
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			kept = append(kept, part)
+		}
+	}
+	return strings.Join(kept, "\n\n")
+}
+
+func description(s string) string {
+	r := []rune(strings.TrimSpace(s))
+	if len(r) <= maxDescriptionChars {
+		return string(r)
+	}
+	return string(r[:maxDescriptionChars])
+}
+
+func cleanText(s string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(s)), " ")
+}
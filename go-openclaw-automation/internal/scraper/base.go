@@ -0,0 +1,84 @@
+// Define an interface for all scrapers
+// Ensure consistency
+
+package scraper
+
+import (
+	"context"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+type Job struct {
+	Title       string
+	Company     string
+	URL         string
+	Location    string
+	Salary      string
+	Techstack   string
+	Description string
+	Source      string
+	PostedDate  string
+	MatchScore  int
+}
+
+// Scraper defines the interface that all platform scrapers must implement
+type Scraper interface {
+	//Scrape jobs from the platform
+	Scrape(ctx context.Context, browserCtx playwright.BrowserContext) ([]Job, error)
+
+	//Name is the platform name (TopCV, Facebook, ...)
+	Name() string
+}
+
+// StreamScraper is implemented by scrapers that emit each job as soon as it is found,
+// so a timeout or a late block keeps everything collected up to that point
+type StreamScraper interface {
+	Scraper
+
+	//ScrapeStream sends jobs to out as they are found. It must not close out.
+	ScrapeStream(ctx context.Context, browserCtx playwright.BrowserContext, out chan<- Job) error
+}
+
+// Stream runs any scraper in streaming mode.
+// Scrapers that only implement Scrape are adapted: their jobs are sent once Scrape returns.
+func Stream(ctx context.Context, s Scraper, browserCtx playwright.BrowserContext, out chan<- Job) error {
+	if ss, ok := s.(StreamScraper); ok {
+		return ss.ScrapeStream(ctx, browserCtx, out)
+	}
+
+	jobs, err := s.Scrape(ctx, browserCtx)
+	for _, job := range jobs {
+		if sendErr := Send(ctx, out, job); sendErr != nil {
+			return sendErr
+		}
+	}
+	return err
+}
+
+// Collect runs a StreamScraper to completion and returns every job it sent.
+// Used to implement Scrape on top of ScrapeStream.
+func Collect(ctx context.Context, s StreamScraper, browserCtx playwright.BrowserContext) ([]Job, error) {
+	out := make(chan Job)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.ScrapeStream(ctx, browserCtx, out)
+		close(out)
+	}()
+
+	var jobs []Job
+	for job := range out {
+		jobs = append(jobs, job)
+	}
+	return jobs, <-errCh
+}
+
+// Send delivers a job to out unless ctx is cancelled first
+func Send(ctx context.Context, out chan<- Job, job Job) error {
+	select {
+	case out <- job:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
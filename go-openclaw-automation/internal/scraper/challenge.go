@@ -0,0 +1,15 @@
+package scraper
+
+import (
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+)
+
+// NewChallengeDetector creates the anti-bot detector of a platform with the wait and evidence
+// settings of config; opts carries the site's own selectors (login form, captcha, Turnstile checkbox)
+func NewChallengeDetector(cfg *config.Config, platform string, opts browser.ChallengeOptions) *browser.ChallengeDetector {
+	opts.Wait = cfg.Challenge.Wait
+	opts.Interval = cfg.Challenge.RecheckInterval
+	opts.EvidenceDir = cfg.Challenge.EvidenceDir
+	return browser.NewChallengeDetector(platform, opts)
+}
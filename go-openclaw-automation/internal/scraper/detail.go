@@ -0,0 +1,180 @@
+// Shared detail-page fetcher
+// One tab pool for every scraper of a run: a global cap on open tabs and a cap per site,
+// navigation retried on timeouts, results cached by URL for the rest of the run
+
+package scraper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-openclaw-automation/internal/browser"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// Tab caps used when config.yaml does not set max_detail_tabs / max_detail_tabs_per_domain
+const (
+	DefaultMaxDetailTabs          = 6
+	DefaultMaxDetailTabsPerDomain = 3
+)
+
+const (
+	//detailAttempts is how many times a detail page that times out is loaded
+	detailAttempts = 2
+	detailTimeout  = 30000 //ms, per navigation
+)
+
+// ReadDetail extracts a job from a loaded detail page.
+// A zero Job (empty URL) means the page holds no job; it is cached like any other result.
+type ReadDetail func(page playwright.Page) (Job, error)
+
+// DetailAware scrapers open detail pages through the runner's shared fetcher
+type DetailAware interface {
+	SetDetailFetcher(fetcher *DetailFetcher)
+}
+
+// DetailFetcher opens detail pages in new tabs within the tab caps. Safe for concurrent use.
+type DetailFetcher struct {
+	tabs         chan struct{} //global tab slots
+	maxPerDomain int
+	retryDelay   time.Duration
+
+	mu      sync.Mutex
+	domains map[string]chan struct{} //tab slots per host
+	results map[string]*detailResult
+}
+
+type detailResult struct {
+	done chan struct{} //closed once job and err are set
+	job  Job
+	err  error
+}
+
+// NewDetailFetcher creates an empty pool; caps <= 0 use the defaults
+func NewDetailFetcher(maxTabs, maxPerDomain int) *DetailFetcher {
+	if maxTabs <= 0 {
+		maxTabs = DefaultMaxDetailTabs
+	}
+	if maxPerDomain <= 0 {
+		maxPerDomain = DefaultMaxDetailTabsPerDomain
+	}
+	return &DetailFetcher{
+		tabs:         make(chan struct{}, maxTabs),
+		maxPerDomain: maxPerDomain,
+		retryDelay:   2 * time.Second,
+		domains:      make(map[string]chan struct{}),
+		results:      make(map[string]*detailResult),
+	}
+}
+
+// Fetch opens rawURL in a new tab once a slot is free, runs read on it and closes the tab.
+// The result is cached for the run: later calls for the same URL (even concurrent ones)
+// reuse it. Failures are not cached, the next call tries again.
+func (f *DetailFetcher) Fetch(ctx context.Context, browserCtx playwright.BrowserContext, rawURL string, read ReadDetail) (Job, error) {
+	return f.cached(ctx, rawURL, func() (Job, error) {
+		release, err := f.acquire(ctx, rawURL)
+		if err != nil {
+			return Job{}, err
+		}
+		defer release()
+
+		page, err := browserCtx.NewPage()
+		if err != nil {
+			return Job{}, fmt.Errorf("could not open detail tab for %s: %w", rawURL, err)
+		}
+		defer page.Close()
+
+		if err := f.retry(ctx, rawURL, func() error {
+			_, err := browser.Goto(ctx, page, rawURL, playwright.PageGotoOptions{
+				WaitUntil: playwright.WaitUntilStateDomcontentloaded,
+				Timeout:   playwright.Float(detailTimeout),
+			})
+			return err
+		}); err != nil {
+			return Job{}, fmt.Errorf("could not navigate to %s: %w", rawURL, err)
+		}
+		return read(page)
+	})
+}
+
+// cached runs fetch once per URL, callers arriving meanwhile wait for its result
+func (f *DetailFetcher) cached(ctx context.Context, rawURL string, fetch func() (Job, error)) (Job, error) {
+	f.mu.Lock()
+	if r, ok := f.results[rawURL]; ok {
+		f.mu.Unlock()
+		select {
+		case <-r.done:
+			return r.job, r.err
+		case <-ctx.Done():
+			return Job{}, ctx.Err()
+		}
+	}
+	r := &detailResult{done: make(chan struct{})}
+	f.results[rawURL] = r
+	f.mu.Unlock()
+
+	r.job, r.err = fetch()
+	if r.err != nil {
+		f.mu.Lock()
+		delete(f.results, rawURL)
+		f.mu.Unlock()
+	}
+	close(r.done)
+	return r.job, r.err
+}
+
+// acquire waits for a slot of the URL's site, then for a global one.
+// The site slot comes first so a busy site never holds global slots other sites could use.
+func (f *DetailFetcher) acquire(ctx context.Context, rawURL string) (func(), error) {
+	domain := f.domainSlots(browser.Domain(rawURL))
+	select {
+	case domain <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	select {
+	case f.tabs <- struct{}{}:
+	case <-ctx.Done():
+		<-domain
+		return nil, ctx.Err()
+	}
+
+	return func() {
+		<-f.tabs
+		<-domain
+	}, nil
+}
+
+func (f *DetailFetcher) domainSlots(host string) chan struct{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	slots, ok := f.domains[host]
+	if !ok {
+		slots = make(chan struct{}, f.maxPerDomain)
+		f.domains[host] = slots
+	}
+	return slots
+}
+
+// retry runs navigate again when it times out, up to detailAttempts times
+func (f *DetailFetcher) retry(ctx context.Context, rawURL string, navigate func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := navigate()
+		if err == nil || !errors.Is(err, playwright.ErrTimeout) || attempt >= detailAttempts {
+			return err
+		}
+		log.Printf("      🔁 Timeout loading %s, retrying (%d/%d)...", rawURL, attempt+1, detailAttempts)
+
+		select {
+		case <-time.After(f.retryDelay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package scraper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetailFetcher_CachesByURL(t *testing.T) {
+	f := NewDetailFetcher(0, 0)
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	fetch := func() (Job, error) {
+		calls.Add(1)
+		<-release
+		return Job{Title: "Junior Golang Developer", URL: "https://www.topcv.vn/viec-lam/1"}, nil
+	}
+
+	//concurrent callers for the same URL wait for the first fetch
+	var wg sync.WaitGroup
+	jobs := make([]Job, 3)
+	for i := range jobs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			jobs[i], _ = f.cached(context.Background(), "https://www.topcv.vn/viec-lam/1", fetch)
+		}(i)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.EqualValues(t, 1, calls.Load())
+	for _, job := range jobs {
+		assert.Equal(t, "Junior Golang Developer", job.Title)
+	}
+
+	//later calls too
+	job, err := f.cached(context.Background(), "https://www.topcv.vn/viec-lam/1", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, "Junior Golang Developer", job.Title)
+	assert.EqualValues(t, 1, calls.Load())
+}
+
+func TestDetailFetcher_FailuresAreNotCached(t *testing.T) {
+	f := NewDetailFetcher(0, 0)
+
+	calls := 0
+	fetch := func() (Job, error) {
+		calls++
+		if calls == 1 {
+			return Job{}, errors.New("tab crashed")
+		}
+		return Job{URL: "https://itviec.com/it-jobs/1"}, nil
+	}
+
+	_, err := f.cached(context.Background(), "https://itviec.com/it-jobs/1", fetch)
+	assert.Error(t, err)
+	job, err := f.cached(context.Background(), "https://itviec.com/it-jobs/1", fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://itviec.com/it-jobs/1", job.URL)
+	assert.Equal(t, 2, calls)
+}
+
+func TestDetailFetcher_TabCaps(t *testing.T) {
+	f := NewDetailFetcher(2, 1)
+	ctx := context.Background()
+
+	releaseA, err := f.acquire(ctx, "https://www.topcv.vn/viec-lam/1")
+	require.NoError(t, err)
+
+	//same site (with or without www.) waits for the per-domain slot
+	blocked, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = f.acquire(blocked, "https://topcv.vn/viec-lam/2")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	//another site gets the second global slot, a third one waits
+	releaseB, err := f.acquire(ctx, "https://itviec.com/it-jobs/1")
+	require.NoError(t, err)
+	blocked, cancel = context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = f.acquire(blocked, "https://topdev.vn/viec-lam/1")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	releaseA()
+	releaseC, err := f.acquire(ctx, "https://topdev.vn/viec-lam/1")
+	require.NoError(t, err)
+
+	releaseB()
+	releaseC()
+	assert.Len(t, f.tabs, 0, "every slot is given back")
+}
+
+func TestDetailFetcher_RetriesTimeouts(t *testing.T) {
+	f := NewDetailFetcher(0, 0)
+	f.retryDelay = 0
+	timeout := fmt.Errorf("%w: %w", playwright.ErrPlaywright, playwright.ErrTimeout)
+
+	calls := 0
+	err := f.retry(context.Background(), "https://vn.indeed.com/viewjob?jk=1", func() error {
+		calls++
+		if calls == 1 {
+			return timeout
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls, "a timeout is retried")
+
+	calls = 0
+	err = f.retry(context.Background(), "https://vn.indeed.com/viewjob?jk=2", func() error {
+		calls++
+		return timeout
+	})
+	assert.ErrorIs(t, err, playwright.ErrTimeout)
+	assert.Equal(t, detailAttempts, calls)
+
+	calls = 0
+	err = f.retry(context.Background(), "https://vn.indeed.com/viewjob?jk=3", func() error {
+		calls++
+		return errors.New("net::ERR_NAME_NOT_RESOLVED")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls, "other errors are not retried")
+}
@@ -0,0 +1,473 @@
+// Navigate to Facebook groups
+// Search for keywords
+// Extract posts
+// Filter by location, date, keywords
+// Return jobs
+
+package facebook
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/filter"
+	"go-openclaw-automation/internal/scraper"
+	"go-openclaw-automation/internal/scraper/selectors"
+	"go-openclaw-automation/utils"
+	"log"
+	"math/rand"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// Caps from the Node platformConfigs.facebook, used when config.yaml does not set them
+const (
+	defaultMaxPostsPerGroup   = 8
+	defaultMaxNewJobsPerGroup = 5
+	defaultStopAfterTotalJobs = 8
+)
+
+// maxDescriptionChars keeps the end of long posts, where the contact/apply info usually is
+const maxDescriptionChars = 1500
+
+var (
+	permalinkRegex = regexp.MustCompile(`/(posts|permalink)/\d+`)
+	trackingRegex  = regexp.MustCompile(`[?&](__cft__|__tn__|ref)[^=]*=.*$`)
+
+	//reactions, comment box and footer that follow the post text
+	uiClutterRegexes = []*regexp.Regexp{
+		regexp.MustCompile(`(?s)Tất cả cảm xúc:.*$`),
+		regexp.MustCompile(`(?s)All reactions:.*$`),
+		regexp.MustCompile(`(?s)Facebook Facebook Facebook.*$`),
+		regexp.MustCompile(`(?s)Viết câu trả lời\.\.\..*$`),
+		regexp.MustCompile(`(?s)Viết bình luận công khai.*$`),
+		regexp.MustCompile(`(?s)Write a comment.*$`),
+		regexp.MustCompile(`(?s)Thích\s+Bình luận\s+Chia sẻ.*$`),
+		regexp.MustCompile(`(?s)Like\s+Comment\s+Share.*$`),
+	}
+	//"... Xem thêm" / "... See more": the truncated preview repeated before the full text
+	seeMoreRegex = regexp.MustCompile(`(?i)(?:\.\.\.|…)\s*(?:Xem thêm|See more)`)
+)
+
+type FacebookScraper struct {
+	cfg       *config.Config
+	details   *scraper.DetailFetcher //tab pool for post pages
+	seen      scraper.SeenFunc       //posts stored by previous runs (nil = none)
+	sel       *selectors.Pack
+	challenge *browser.ChallengeDetector
+}
+
+func init() {
+	scraper.Register("facebook", func(cfg *config.Config) scraper.Scraper {
+		return NewFacebookScraper(cfg)
+	})
+}
+
+func NewFacebookScraper(cfg *config.Config) *FacebookScraper {
+	sel := selectors.ForPlatform(cfg.SelectorsPath, "facebook")
+	return &FacebookScraper{
+		cfg:       cfg,
+		details:   scraper.NewDetailFetcher(0, 0),
+		sel:       sel,
+		challenge: scraper.NewChallengeDetector(cfg, "facebook", browser.ChallengeOptions{LoginSelector: sel.CSS("auth.login_form")}),
+	}
+}
+
+func (s *FacebookScraper) Name() string {
+	return "Facebook"
+}
+
+// SetSeen skips posts already stored by previous runs without opening them
+func (s *FacebookScraper) SetSeen(seen scraper.SeenFunc) {
+	s.seen = seen
+}
+
+// SetDetailFetcher shares the runner's tab pool
+func (s *FacebookScraper) SetDetailFetcher(details *scraper.DetailFetcher) {
+	s.details = details
+}
+
+func (s *FacebookScraper) Scrape(ctx context.Context, browserCtx playwright.BrowserContext) ([]scraper.Job, error) {
+	return scraper.Collect(ctx, s, browserCtx)
+}
+
+// ScrapeStream searches every configured group for the keywords (recent posts only),
+// opens each post in a new tab and sends the ones that pass the job filter.
+// It stops a group after max_posts_per_group posts or max_new_jobs_per_group jobs,
+// and the whole run after stop_after_total_jobs jobs.
+func (s *FacebookScraper) ScrapeStream(ctx context.Context, browserCtx playwright.BrowserContext, out chan<- scraper.Job) error {
+	log.Printf("📘 Searching Facebook Groups (Authenticated)... (selectors v%s)", s.sel.Version)
+	caps := capsFor(s.cfg.Platform("facebook"))
+	seenURLs := make(map[string]bool)
+	total := 0
+
+	page, err := browserCtx.NewPage()
+	if err != nil {
+		return fmt.Errorf("facebook: failed to create page: %w", err)
+	}
+	defer page.Close()
+
+	s.warmUp(ctx, page)
+
+	for _, group := range s.cfg.FacebookGroups {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if total >= caps.totalJobs {
+			log.Printf("  🛑 Stopped early after reaching %d jobs across groups", caps.totalJobs)
+			return nil
+		}
+
+		groupURL := normalizeGroupURL(group)
+		found, err := s.scrapeGroup(ctx, browserCtx, page, groupURL, caps, caps.totalJobs-total, seenURLs, out)
+		total += found
+		if err != nil {
+			return err
+		}
+
+		//cool down between groups
+		browser.RandomDelay(4000, 8000)
+	}
+	return nil
+}
+
+type postCaps struct {
+	postsPerGroup int
+	jobsPerGroup  int
+	totalJobs     int
+}
+
+func capsFor(platform config.PlatformConfig) postCaps {
+	caps := postCaps{
+		postsPerGroup: platform.MaxPostsPerGroup,
+		jobsPerGroup:  platform.MaxNewJobsPerGroup,
+		totalJobs:     platform.StopAfterTotalJobs,
+	}
+	if caps.postsPerGroup <= 0 {
+		caps.postsPerGroup = defaultMaxPostsPerGroup
+	}
+	if caps.jobsPerGroup <= 0 {
+		caps.jobsPerGroup = defaultMaxNewJobsPerGroup
+	}
+	if caps.totalJobs <= 0 {
+		caps.totalJobs = defaultStopAfterTotalJobs
+	}
+	return caps
+}
+
+// warmUp browses the home feed for a few seconds, like a person opening Facebook first
+func (s *FacebookScraper) warmUp(ctx context.Context, page playwright.Page) {
+	log.Println("🏠 Navigating to Facebook Home for warm-up...")
+	if _, err := browser.Goto(ctx, page, "https://www.facebook.com/", playwright.PageGotoOptions{
+		WaitUntil: playwright.WaitUntilStateDomcontentloaded,
+		Timeout:   playwright.Float(30000),
+	}); err != nil {
+		log.Printf("⚠️ Warm-up failed (non-critical): %v", err)
+		return
+	}
+
+	duration := time.Duration(rand.Intn(4000)+4000) * time.Millisecond
+	log.Printf("⏳ Warming up for %v...", duration)
+	for start := time.Now(); time.Since(start) < duration; {
+		browser.MouseJiggle(page)
+		browser.RandomDelay(1000, 2000)
+	}
+}
+
+// scrapeGroup searches one group for every keyword and returns how many jobs it sent.
+// remaining is what is left of the run-wide job cap.
+func (s *FacebookScraper) scrapeGroup(ctx context.Context, browserCtx playwright.BrowserContext, page playwright.Page, groupURL string, caps postCaps, remaining int, seenURLs map[string]bool, out chan<- scraper.Job) (int, error) {
+	inspected, found := 0, 0
+
+	for _, keyword := range s.cfg.Keywords {
+		if ctx.Err() != nil {
+			return found, ctx.Err()
+		}
+		if inspected >= caps.postsPerGroup || found >= caps.jobsPerGroup || found >= remaining {
+			break
+		}
+
+		searchURL := fmt.Sprintf("%s/search?q=%s&filters=%s", groupURL, url.QueryEscape(keyword), recentPostsFilter(time.Now().Year()))
+		log.Printf("  👥 Visiting Group Search: %s | keyword=%q", groupURL, keyword)
+
+		page.SetExtraHTTPHeaders(map[string]string{"Referer": groupURL})
+		resp, err := browser.Goto(ctx, page, searchURL, playwright.PageGotoOptions{
+			WaitUntil: playwright.WaitUntilStateDomcontentloaded,
+			Timeout:   playwright.Float(30000),
+		})
+		page.SetExtraHTTPHeaders(map[string]string{})
+		if err != nil {
+			log.Printf("    ⚠️ Navigation failed: %v", err)
+			continue
+		}
+
+		if err := s.challenge.Check(ctx, page, resp); err != nil {
+			return found, fmt.Errorf("facebook: %s: %w", groupURL, err)
+		}
+
+		browser.RandomDelay(3500, 6500)
+		browser.MouseJiggle(page)
+
+		log.Println("    ⏳ Loading posts...")
+		browser.HumanScroll(page)
+		browser.RandomDelay(3000, 5000)
+
+		postSelector := s.sel.CSS("search.post")
+		count, _ := page.Locator(postSelector).Count()
+		log.Printf("    📄 Found %d potential posts in feed.", count)
+
+		for i := 0; i < count; i++ {
+			if inspected >= caps.postsPerGroup || found >= caps.jobsPerGroup || found >= remaining {
+				break
+			}
+			post := page.Locator(postSelector).Nth(i)
+
+			postURL, postedDate, ok := s.previewPost(post)
+			if !ok || seenURLs[postURL] {
+				continue
+			}
+			seenURLs[postURL] = true
+			if s.seen != nil && s.seen(postURL) {
+				continue
+			}
+			inspected++
+
+			log.Printf("    🔍 Inspecting Post %d/%d: %s", inspected, caps.postsPerGroup, postURL)
+			job, err := s.readPost(ctx, browserCtx, postURL, postedDate)
+			if err != nil {
+				return found, err
+			}
+			if job == nil {
+				continue
+			}
+
+			log.Printf("      ✅ Valid Job Found: %s", job.Title)
+			if err := scraper.Send(ctx, out, *job); err != nil {
+				return found, err
+			}
+			found++
+			browser.RandomDelay(1500, 3000)
+		}
+	}
+	return found, nil
+}
+
+// previewPost reads the permalink and timestamp of a feed post.
+// Posts that are clearly not for us (senior-only, Hanoi-only) are rejected before opening them.
+func (s *FacebookScraper) previewPost(post playwright.Locator) (string, string, bool) {
+	if visible, _ := post.IsVisible(); visible {
+		post.ScrollIntoViewIfNeeded()
+	}
+	browser.RandomDelay(1200, 2800)
+
+	if text, err := post.InnerText(playwright.LocatorInnerTextOptions{Timeout: playwright.Float(2000)}); err == nil {
+		if filter.AnalyzeLocation(text).HanoiOnly() {
+			log.Println("      ❌ [Early] Filtered out: Location is Hanoi (and no others)")
+			return "", "", false
+		}
+	}
+
+	links, _ := s.sel.In(post, "post.permalink").All()
+	postURL := ""
+	for _, link := range links {
+		href, _ := link.GetAttribute("href")
+		if permalinkRegex.MatchString(href) {
+			postURL = normalizePostURL(href)
+			break
+		}
+	}
+	if postURL == "" {
+		return "", "", false
+	}
+	return postURL, s.timestamp(s.sel.In(post, "post.timestamp").First()), true
+}
+
+// readPost opens the post in a new tab and returns the job, nil when the post is not a valid job.
+// Only a login wall or block is an error; a post that fails to load is skipped.
+func (s *FacebookScraper) readPost(ctx context.Context, browserCtx playwright.BrowserContext, postURL, postedDate string) (*scraper.Job, error) {
+	job, err := s.details.Fetch(ctx, browserCtx, postURL, s.readPostPage(ctx, postURL, postedDate))
+	//every following post would hit the same wall
+	if errors.Is(err, browser.ErrLoginRequired) || errors.Is(err, browser.ErrBlocked) {
+		return nil, err
+	}
+	if err != nil {
+		log.Printf("      ⚠️ %v", err)
+		return nil, nil
+	}
+	if job.URL == "" {
+		return nil, nil
+	}
+	return &job, nil
+}
+
+// readPostPage reads a loaded post; a zero Job when the post is not a valid job
+func (s *FacebookScraper) readPostPage(ctx context.Context, postURL, postedDate string) scraper.ReadDetail {
+	return func(detailPage playwright.Page) (scraper.Job, error) {
+		if err := s.challenge.Check(ctx, detailPage, nil); err != nil {
+			return scraper.Job{}, fmt.Errorf("facebook: %s: %w", postURL, err)
+		}
+
+		detailPage.WaitForSelector(s.sel.CSS("detail.message"), playwright.PageWaitForSelectorOptions{
+			State:   playwright.WaitForSelectorStateVisible,
+			Timeout: playwright.Float(5000),
+		})
+		browser.MouseJiggle(detailPage)
+		browser.RandomDelay(1500, 3200)
+
+		//the post text may be split over several story_message blocks
+		var body string
+		if texts, err := s.sel.OnPage(detailPage, "detail.message").AllInnerTexts(); err == nil && len(texts) > 0 {
+			body = strings.Join(texts, "\n")
+		} else if text, err := s.sel.OnPage(detailPage, "detail.main").First().InnerText(); err == nil {
+			body = text
+		}
+		body = cleanPostText(body)
+		if body == "" {
+			return scraper.Job{}, nil
+		}
+
+		author, _ := s.sel.OnPage(detailPage, "detail.author").First().TextContent(playwright.LocatorTextContentOptions{
+			Timeout: playwright.Float(1000),
+		})
+		author = strings.TrimSpace(author)
+		if author == "" {
+			author = "Facebook Group"
+		}
+
+		//the feed preview rarely has a usable date, the detail page often does
+		if postedDate == "Recent" {
+			postedDate = s.timestamp(s.sel.OnPage(detailPage, "detail.timestamp").First())
+		}
+
+		title, _ := detailPage.Title()
+		title = strings.TrimSpace(strings.TrimSuffix(title, " | Facebook"))
+
+		location := filter.AnalyzeLocation(body)
+		if location.HanoiOnly() {
+			log.Println("      ❌ Filtered out: Location is Hanoi (and no others)")
+			return scraper.Job{}, nil
+		}
+
+		job := scraper.Job{
+			Title:       title,
+			Company:     author,
+			URL:         postURL,
+			Location:    "Unknown",
+			Description: tail(body, maxDescriptionChars),
+			Source:      "Facebook",
+			Techstack:   "Golang",
+			PostedDate:  postedDate,
+		}
+		if location.Preferred != "Unknown" {
+			job.Location = location.Preferred
+		}
+
+		//filter on the full text, only count jobs that would survive the pipeline filter
+		full := job
+		full.Description = body
+		if !filter.ShouldIncludeJob(full) {
+			log.Println("      ❌ Filtered out: keyword, level or date")
+			return scraper.Job{}, nil
+		}
+		return job, nil
+	}
+}
+
+// timestamp reads a post date as YYYY-MM-DD: abbr data-utime (unix seconds), otherwise the
+// aria-label of the timestamp link ("14 tháng 10 lúc 09:30", "3 giờ"), else "Recent"
+func (s *FacebookScraper) timestamp(loc playwright.Locator) string {
+	if count, _ := loc.Count(); count == 0 {
+		return "Recent"
+	}
+	if utime, err := loc.GetAttribute("data-utime", playwright.LocatorGetAttributeOptions{Timeout: playwright.Float(500)}); err == nil && utime != "" {
+		if sec, err := strconv.ParseInt(utime, 10, 64); err == nil {
+			return time.Unix(sec, 0).UTC().Format("2006-01-02")
+		}
+	}
+	if label, err := loc.GetAttribute("aria-label", playwright.LocatorGetAttributeOptions{Timeout: playwright.Float(500)}); err == nil {
+		if label = strings.TrimSpace(label); label != "" && len([]rune(label)) <= 140 {
+			return utils.PostedDate(label, time.Now())
+		}
+	}
+	return "Recent"
+}
+
+// normalizeGroupURL points mobile group links at www and drops the trailing slash
+func normalizeGroupURL(group string) string {
+	group = strings.TrimRight(strings.TrimSpace(group), "/")
+	group = strings.Replace(group, "mbasic.facebook.com", "www.facebook.com", 1)
+	group = strings.Replace(group, "://m.facebook.com", "://www.facebook.com", 1)
+	return group
+}
+
+// normalizePostURL makes the permalink absolute and strips tracking params
+func normalizePostURL(href string) string {
+	if strings.HasPrefix(href, "/") {
+		href = "https://www.facebook.com" + href
+	}
+	return trackingRegex.ReplaceAllString(href, "")
+}
+
+// cleanPostText removes the UI around the post text
+func cleanPostText(text string) string {
+	for _, re := range uiClutterRegexes {
+		text = re.ReplaceAllString(text, "")
+	}
+	if matches := seeMoreRegex.FindAllStringIndex(text, -1); len(matches) > 0 {
+		text = text[matches[len(matches)-1][1]:]
+	}
+	return strings.TrimSpace(text)
+}
+
+// recentPostsFilter is the base64 "filters" param of a group search:
+// "Recent posts" plus "Date posted" limited to the given year
+func recentPostsFilter(year int) string {
+	type filterArg struct {
+		Name string `json:"name"`
+		Args string `json:"args"`
+	}
+	type creationTime struct {
+		StartYear  string `json:"start_year"`
+		StartMonth string `json:"start_month"`
+		EndYear    string `json:"end_year"`
+		EndMonth   string `json:"end_month"`
+		StartDay   string `json:"start_day"`
+		EndDay     string `json:"end_day"`
+	}
+
+	y := strconv.Itoa(year)
+	creationArgs, _ := json.Marshal(creationTime{
+		StartYear:  y,
+		StartMonth: y + "-1",
+		EndYear:    y,
+		EndMonth:   y + "-12",
+		StartDay:   y + "-1-1",
+		EndDay:     y + "-12-31",
+	})
+	recent, _ := json.Marshal(filterArg{Name: "recent_posts", Args: ""})
+	creation, _ := json.Marshal(filterArg{Name: "creation_time", Args: string(creationArgs)})
+
+	filters, _ := json.Marshal(struct {
+		RecentPosts  string `json:"recent_posts:0"`
+		CreationTime string `json:"rp_creation_time:0"`
+	}{string(recent), string(creation)})
+	return base64.StdEncoding.EncodeToString(filters)
+}
+
+// tail keeps the last max characters of s, prefixed with "..." when cut
+func tail(s string, max int) string {
+	r := []rune(s)
+	if len(r) <= max {
+		return s
+	}
+	return "..." + string(r[len(r)-max:])
+}
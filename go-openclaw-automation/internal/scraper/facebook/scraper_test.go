@@ -0,0 +1,94 @@
+package facebook
+
+import (
+	"context"
+	"errors"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper/scrapertest"
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecentPostsFilter(t *testing.T) {
+	//same value as RECENT_POSTS_FILTER in execution/scrapers/facebook.js
+	node := "eyJyZWNlbnRfcG9zdHM6MCI6IntcIm5hbWVcIjpcInJlY2VudF9wb3N0c1wiLFwiYXJnc1wiOlwiXCJ9IiwicnBfY3JlYXRpb25fdGltZTowIjoie1wibmFtZVwiOlwiY3JlYXRpb25fdGltZVwiLFwiYXJnc1wiOlwie1xcXCJzdGFydF95ZWFyXFxcIjpcXFwiMjAyNlxcXCIsXFxcInN0YXJ0X21vbnRoXFxcIjpcXFwiMjAyNi0xXFxcIixcXFwiZW5kX3llYXJcXFwiOlxcXCIyMDI2XFxcIixcXFwiZW5kX21vbnRoXFxcIjpcXFwiMjAyNi0xMlxcXCIsXFxcInN0YXJ0X2RheVxcXCI6XFxcIjIwMjYtMS0xXFxcIixcXFwiZW5kX2RheVxcXCI6XFxcIjIwMjYtMTItMzFcXFwifVwifSJ9"
+	assert.Equal(t, node, recentPostsFilter(2026))
+}
+
+func TestNormalizeURLs(t *testing.T) {
+	assert.Equal(t, "https://www.facebook.com/groups/golang.org.vn", normalizeGroupURL("https://m.facebook.com/groups/golang.org.vn/"))
+	assert.Equal(t, "https://www.facebook.com/groups/123", normalizeGroupURL("https://mbasic.facebook.com/groups/123"))
+
+	assert.Equal(t, "https://www.facebook.com/groups/123/posts/456/", normalizePostURL("/groups/123/posts/456/?__cft__[0]=abc&__tn__=R"))
+	assert.Equal(t, "https://www.facebook.com/groups/123/permalink/789/", normalizePostURL("https://www.facebook.com/groups/123/permalink/789/?ref=share"))
+}
+
+func TestCleanPostText(t *testing.T) {
+	text := "Tuyển Golang intern... Xem thêm\nTuyển Golang intern tại HCM, gửi CV qua inbox.\nTất cả cảm xúc: 12\nThích Bình luận Chia sẻ"
+	assert.Equal(t, "Tuyển Golang intern tại HCM, gửi CV qua inbox.", cleanPostText(text))
+}
+
+func TestCapsFor(t *testing.T) {
+	assert.Equal(t, postCaps{postsPerGroup: 8, jobsPerGroup: 5, totalJobs: 8}, capsFor(config.PlatformConfig{}))
+	assert.Equal(t, postCaps{postsPerGroup: 3, jobsPerGroup: 1, totalJobs: 2},
+		capsFor(config.PlatformConfig{MaxPostsPerGroup: 3, MaxNewJobsPerGroup: 1, StopAfterTotalJobs: 2}))
+}
+
+// TestFacebookScraper_Scrape_LoginWall verifies that an expired session stops the scraper with an error
+func TestFacebookScraper_Scrape_LoginWall(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+
+	mockHTML := `<html><title>Facebook - Log In</title><body><form><input name="email"><input name="pass"></form></body></html>`
+	if err := browserCtx.Route("**/*", func(route playwright.Route) {
+		route.Fulfill(playwright.RouteFulfillOptions{
+			Status: playwright.Int(200),
+			Body:   mockHTML,
+		})
+	}); err != nil {
+		t.Fatalf("could not set up route interception: %v", err)
+	}
+
+	cfg := &config.Config{Keywords: []string{"golang"}, FacebookGroups: []string{"https://www.facebook.com/groups/golang.org.vn"}}
+	cfg.Challenge = config.ChallengeRules{EvidenceDir: t.TempDir()}
+	jobs, err := NewFacebookScraper(cfg).Scrape(context.Background(), browserCtx)
+
+	assert.True(t, errors.Is(err, browser.ErrLoginRequired), "got %v", err)
+	assert.Empty(t, jobs)
+}
+
+// TestFacebookScraper_Scrape_Replay serves a recorded group search from testdata/replay (no network).
+// The group has a valid HCM post, a Hanoi-only post (rejected in the feed) and a senior post
+// (rejected on the detail page); stop_after_total_jobs=1 keeps the second group from being visited.
+// Re-record with: SCRAPERTEST_RECORD=1 go test -run TestFacebookScraper_Scrape_Replay ./internal/scraper/facebook/
+func TestFacebookScraper_Scrape_Replay(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+	scrapertest.Attach(t, browserCtx, "testdata/replay", scrapertest.ModeFromEnv())
+
+	cfg := &config.Config{
+		Keywords: []string{"golang"},
+		FacebookGroups: []string{
+			"https://www.facebook.com/groups/golang.org.vn/",
+			"https://www.facebook.com/groups/never-visited",
+		},
+		EnabledPlatforms: map[string]config.PlatformConfig{
+			"facebook": {Enabled: true, StopAfterTotalJobs: 1},
+		},
+	}
+	jobs, err := NewFacebookScraper(cfg).Scrape(context.Background(), browserCtx)
+
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 1) {
+		job := jobs[0]
+		assert.Equal(t, "Tuyển Golang Intern - Golang Vietnam", job.Title)
+		assert.Equal(t, "Nguyễn Văn A", job.Company)
+		assert.Equal(t, "https://www.facebook.com/groups/golang.org.vn/posts/1001/", job.URL)
+		assert.Equal(t, "HCM", job.Location)
+		assert.Equal(t, time.Now().Add(-3*time.Hour).Format("2006-01-02"), job.PostedDate, "aria-label \"3 giờ\"")
+		assert.Equal(t, "Facebook", job.Source)
+		assert.Equal(t, "Tuyển Golang Intern tại Quận 1, HCM. Gửi CV qua inbox.", job.Description)
+	}
+}
@@ -0,0 +1,90 @@
+// RSS 2.0 / Atom job feeds
+// No browser needed: feeds are fetched with the shared HTTP client and parsed with encoding/xml
+
+package feed
+
+import (
+	"context"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper"
+	"log"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+func init() {
+	scraper.Register("feeds", func(cfg *config.Config) scraper.Scraper {
+		return NewFeedScraper(cfg)
+	})
+}
+
+type FeedScraper struct {
+	cfg    *config.Config
+	client *browser.HTTPClient
+}
+
+func NewFeedScraper(cfg *config.Config) *FeedScraper {
+	return &FeedScraper{
+		cfg:    cfg,
+		client: browser.NewHTTPClient(0),
+	}
+}
+
+// NeedsBrowser is false: feeds are plain XML
+func (s *FeedScraper) NeedsBrowser() bool {
+	return false
+}
+
+// SetHTTPClient shares the runner's cookie jar and response cache
+func (s *FeedScraper) SetHTTPClient(client *browser.HTTPClient) {
+	s.client = client
+}
+
+func (s *FeedScraper) Name() string {
+	return "Feeds"
+}
+
+// Scrape fetches every configured feed; browserCtx is unused
+func (s *FeedScraper) Scrape(ctx context.Context, browserCtx playwright.BrowserContext) ([]scraper.Job, error) {
+	return scraper.Collect(ctx, s, browserCtx)
+}
+
+// ScrapeStream sends the entries of each feed as soon as that feed is parsed.
+// A broken feed is logged and skipped so one bad source does not hide the others.
+func (s *FeedScraper) ScrapeStream(ctx context.Context, browserCtx playwright.BrowserContext, out chan<- scraper.Job) error {
+	log.Printf("📰 Reading %d job feeds...", len(s.cfg.Feeds))
+	seenURLs := make(map[string]bool)
+
+	for _, feedURL := range s.cfg.Feeds {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		jobs, err := s.fetch(ctx, feedURL)
+		if err != nil {
+			log.Printf("  ⚠️ Feed %s: %v", feedURL, err)
+			continue
+		}
+		log.Printf("  📦 %d entries from %s", len(jobs), feedURL)
+
+		for _, job := range jobs {
+			if job.URL == "" || seenURLs[job.URL] {
+				continue
+			}
+			seenURLs[job.URL] = true
+			if err := scraper.Send(ctx, out, job); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *FeedScraper) fetch(ctx context.Context, feedURL string) ([]scraper.Job, error) {
+	data, err := s.client.Get(ctx, feedURL)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
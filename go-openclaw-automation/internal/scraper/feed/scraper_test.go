@@ -0,0 +1,107 @@
+package feed
+
+import (
+	"context"
+	"go-openclaw-automation/internal/config"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	require.NoError(t, err)
+	return data
+}
+
+func TestParse_RSS(t *testing.T) {
+	jobs, err := Parse(readFixture(t, "rss.xml"))
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+
+	assert.Equal(t, "Junior Golang Developer", jobs[0].Title)
+	assert.Equal(t, "ABC Tech", jobs[0].Company)
+	assert.Equal(t, "https://jobs.example.com/remote-jobs/abc-tech-junior-golang-developer", jobs[0].URL)
+	assert.Equal(t, "2026-10-13", jobs[0].PostedDate)
+	assert.Equal(t, "Build backend services in Golang.\nDocker\nPostgreSQL", jobs[0].Description)
+	assert.Equal(t, "Feed", jobs[0].Source)
+
+	//no <link>: guid permalink is used, content:encoded wins over description, channel title as company
+	assert.Equal(t, "https://jobs.example.com/remote-jobs/xyz-backend-engineer", jobs[1].URL)
+	assert.Equal(t, "Remote Back-End Jobs", jobs[1].Company)
+	assert.Equal(t, "2026-10-05", jobs[1].PostedDate)
+	assert.Equal(t, "Go & gRPC microservices, remote friendly.", jobs[1].Description)
+}
+
+func TestParse_Atom(t *testing.T) {
+	jobs, err := Parse(readFixture(t, "atom.xml"))
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+
+	assert.Equal(t, "Golang Intern", jobs[0].Title)
+	assert.Equal(t, "Gopher Co", jobs[0].Company)
+	assert.Equal(t, "https://golang.example.org/jobs/42", jobs[0].URL)
+	assert.Equal(t, "2026-10-14", jobs[0].PostedDate)
+	assert.Equal(t, "Learn Go with us.", jobs[0].Description)
+
+	//published wins over updated, feed title as company
+	assert.Equal(t, "https://golang.example.org/jobs/43", jobs[1].URL)
+	assert.Equal(t, "Go Jobs", jobs[1].Company)
+	assert.Equal(t, "2026-10-01", jobs[1].PostedDate)
+	assert.Equal(t, "Kubernetes & Go", jobs[1].Description)
+}
+
+func TestParse_Unsupported(t *testing.T) {
+	_, err := Parse([]byte(`<html><body>not a feed</body></html>`))
+	assert.Error(t, err)
+
+	_, err = Parse([]byte(`not xml`))
+	assert.Error(t, err)
+}
+
+func TestFeedScraper_Scrape(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rss", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		w.Write(readFixture(t, "rss.xml"))
+	})
+	mux.HandleFunc("/atom", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/atom+xml")
+		w.Write(readFixture(t, "atom.xml"))
+	})
+	mux.HandleFunc("/broken", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "gone", http.StatusGone)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	cfg := &config.Config{
+		//a broken feed and a repeated feed must not stop or duplicate the others
+		Feeds: []string{server.URL + "/broken", server.URL + "/rss", server.URL + "/atom", server.URL + "/rss"},
+	}
+
+	s := NewFeedScraper(cfg)
+	jobs, err := s.Scrape(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, jobs, 4)
+
+	var titles []string
+	for _, job := range jobs {
+		titles = append(titles, job.Title)
+	}
+	assert.Equal(t, []string{"Junior Golang Developer", "Backend Engineer (Go)", "Golang Intern", "Go Developer"}, titles)
+}
+
+func TestFeedScraper_Scrape_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s := NewFeedScraper(&config.Config{Feeds: []string{"http://127.0.0.1:1/rss"}})
+	_, err := s.Scrape(ctx, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+}
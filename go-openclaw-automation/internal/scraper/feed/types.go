@@ -0,0 +1,166 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"go-openclaw-automation/internal/scraper"
+	"go-openclaw-automation/utils"
+	"strings"
+	"time"
+)
+
+// RSS 2.0: <rss><channel><item>
+type rssFeed struct {
+	Channel struct {
+		Title string    `xml:"title"`
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+}
+
+type rssItem struct {
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	GUID        string `xml:"guid"`
+	Description string `xml:"description"`
+	Content     string `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	PubDate     string `xml:"pubDate"`
+	Creator     string `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	Author      string `xml:"author"`
+	Location    string `xml:"location"`
+}
+
+// Atom: <feed><entry>
+type atomFeed struct {
+	Title   string      `xml:"title"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	Title     string     `xml:"title"`
+	Links     []atomLink `xml:"link"`
+	ID        string     `xml:"id"`
+	Summary   string     `xml:"summary"`
+	Content   string     `xml:"content"`
+	Updated   string     `xml:"updated"`
+	Published string     `xml:"published"`
+	Author    struct {
+		Name string `xml:"name"`
+	} `xml:"author"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+}
+
+// Parse decodes an RSS 2.0 or Atom document into jobs, detected by its root element
+func Parse(data []byte) ([]scraper.Job, error) {
+	root, err := rootElement(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch root {
+	case "rss":
+		var feed rssFeed
+		if err := xml.Unmarshal(data, &feed); err != nil {
+			return nil, fmt.Errorf("invalid RSS feed: %w", err)
+		}
+		return rssJobs(feed), nil
+	case "feed":
+		var feed atomFeed
+		if err := xml.Unmarshal(data, &feed); err != nil {
+			return nil, fmt.Errorf("invalid Atom feed: %w", err)
+		}
+		return atomJobs(feed), nil
+	}
+	return nil, fmt.Errorf("unsupported feed root <%s>", root)
+}
+
+func rssJobs(feed rssFeed) []scraper.Job {
+	now := time.Now()
+	jobs := make([]scraper.Job, 0, len(feed.Channel.Items))
+	for _, item := range feed.Channel.Items {
+		link := strings.TrimSpace(item.Link)
+		if link == "" && strings.HasPrefix(item.GUID, "http") {
+			link = strings.TrimSpace(item.GUID)
+		}
+
+		description := item.Content
+		if description == "" {
+			description = item.Description
+		}
+
+		company := firstNonEmpty(item.Creator, item.Author, feed.Channel.Title)
+		jobs = append(jobs, scraper.Job{
+			Title:       strings.TrimSpace(item.Title),
+			Company:     strings.TrimSpace(company),
+			URL:         link,
+			Location:    strings.TrimSpace(item.Location),
+			Description: scraper.HTMLToText(description),
+			Source:      "Feed",
+			PostedDate:  utils.PostedDate(item.PubDate, now),
+		})
+	}
+	return jobs
+}
+
+func atomJobs(feed atomFeed) []scraper.Job {
+	now := time.Now()
+	jobs := make([]scraper.Job, 0, len(feed.Entries))
+	for _, entry := range feed.Entries {
+		description := entry.Content
+		if description == "" {
+			description = entry.Summary
+		}
+
+		jobs = append(jobs, scraper.Job{
+			Title:       strings.TrimSpace(entry.Title),
+			Company:     strings.TrimSpace(firstNonEmpty(entry.Author.Name, feed.Title)),
+			URL:         entry.link(),
+			Description: scraper.HTMLToText(description),
+			Source:      "Feed",
+			PostedDate:  utils.PostedDate(firstNonEmpty(entry.Published, entry.Updated), now),
+		})
+	}
+	return jobs
+}
+
+// link prefers rel="alternate" (or no rel) over self/edit links
+func (e atomEntry) link() string {
+	for _, l := range e.Links {
+		if l.Rel == "" || l.Rel == "alternate" {
+			return strings.TrimSpace(l.Href)
+		}
+	}
+	if len(e.Links) > 0 {
+		return strings.TrimSpace(e.Links[0].Href)
+	}
+	if strings.HasPrefix(e.ID, "http") {
+		return strings.TrimSpace(e.ID)
+	}
+	return ""
+}
+
+func rootElement(data []byte) (string, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := decoder.Token()
+		if err != nil {
+			return "", fmt.Errorf("not an XML feed: %w", err)
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			return start.Name.Local, nil
+		}
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return v
+		}
+	}
+	return ""
+}
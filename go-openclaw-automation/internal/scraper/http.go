@@ -0,0 +1,23 @@
+package scraper
+
+import "go-openclaw-automation/internal/browser"
+
+// BrowserOptional is implemented by scrapers that may not need Chromium.
+// Scrapers without it are assumed to need a browser.
+type BrowserOptional interface {
+	NeedsBrowser() bool
+}
+
+// HTTPAware scrapers fetch over plain HTTP; the runner injects one shared client
+// so every scraper uses the same cookie jar and response cache
+type HTTPAware interface {
+	SetHTTPClient(client *browser.HTTPClient)
+}
+
+// NeedsBrowser reports whether s needs a BrowserContext to run
+func NeedsBrowser(s Scraper) bool {
+	if bo, ok := s.(BrowserOptional); ok {
+		return bo.NeedsBrowser()
+	}
+	return true
+}
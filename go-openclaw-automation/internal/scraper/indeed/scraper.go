@@ -0,0 +1,302 @@
+// Search vn.indeed.com for every keyword × location (newest first)
+// Fast-filter cards on title and location, read the detail of the rest
+// Return jobs
+
+package indeed
+
+import (
+	"context"
+	"fmt"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/filter"
+	"go-openclaw-automation/internal/scraper"
+	"go-openclaw-automation/internal/scraper/selectors"
+	"go-openclaw-automation/utils"
+	"log"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// resultsPerPage is Indeed's page size, used for the "start" offset
+const resultsPerPage = 10
+
+type IndeedScraper struct {
+	cfg       *config.Config
+	details   *scraper.DetailFetcher //tab pool for detail pages
+	seen      scraper.SeenFunc       //jobs stored by previous runs (nil = none)
+	sel       *selectors.Pack
+	challenge *browser.ChallengeDetector
+}
+
+func init() {
+	scraper.Register("indeed", func(cfg *config.Config) scraper.Scraper {
+		return NewIndeedScraper(cfg)
+	})
+}
+
+func NewIndeedScraper(cfg *config.Config) *IndeedScraper {
+	return &IndeedScraper{
+		cfg:       cfg,
+		details:   scraper.NewDetailFetcher(0, 0),
+		sel:       selectors.ForPlatform(cfg.SelectorsPath, "indeed"),
+		challenge: scraper.NewChallengeDetector(cfg, "indeed", browser.ChallengeOptions{}),
+	}
+}
+
+func (s *IndeedScraper) Name() string {
+	return "Indeed"
+}
+
+// SetSeen lets pagination stop early on pages that only hold already-seen jobs
+func (s *IndeedScraper) SetSeen(seen scraper.SeenFunc) {
+	s.seen = seen
+}
+
+// SetDetailFetcher shares the runner's tab pool
+func (s *IndeedScraper) SetDetailFetcher(details *scraper.DetailFetcher) {
+	s.details = details
+}
+
+func (s *IndeedScraper) Scrape(ctx context.Context, browserCtx playwright.BrowserContext) ([]scraper.Job, error) {
+	return scraper.Collect(ctx, s, browserCtx)
+}
+
+// ScrapeStream searches every keyword in every configured location and sends
+// each job once its detail page is read and it passes the job filter
+func (s *IndeedScraper) ScrapeStream(ctx context.Context, browserCtx playwright.BrowserContext, out chan<- scraper.Job) error {
+	log.Printf("💼 Searching Indeed.com... (selectors v%s)", s.sel.Version)
+	seenURLs := make(map[string]bool)
+
+	page, err := browserCtx.NewPage()
+	if err != nil {
+		return fmt.Errorf("indeed: failed to create page: %w", err)
+	}
+	defer page.Close()
+
+	//page and card budget shared by every search of this run
+	pager := scraper.PaginatorFor(s.cfg, "indeed", s.seen)
+
+	for _, keyword := range s.cfg.Keywords {
+		for _, location := range searchLocations(s.cfg.Locations) {
+			for pageNum := 1; pager.HasPage(pageNum); pageNum++ {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if pager.Exhausted() {
+					log.Println("    🛑 Indeed card budget reached.")
+					return nil
+				}
+
+				log.Printf("  🔍 Searching: %s in %s (Page: %d)", keyword, location, pageNum)
+				cards, seenCards, err := s.searchPage(ctx, browserCtx, page, searchURL(keyword, location, pageNum), pager, seenURLs, out)
+				if err != nil {
+					return err
+				}
+				if pager.Done(cards, seenCards) {
+					break
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// searchURL builds a newest-first search; page 1 has no "start" offset, like the Node scraper
+func searchURL(keyword, location string, pageNum int) string {
+	u := fmt.Sprintf("https://vn.indeed.com/jobs?q=%s&l=%s&sort=date", url.QueryEscape(keyword), url.QueryEscape(location))
+	if pageNum > 1 {
+		u += fmt.Sprintf("&start=%d", (pageNum-1)*resultsPerPage)
+	}
+	return u
+}
+
+// searchPage loads one results page and streams its jobs.
+// It returns the number of cards on the page and how many of them were already seen.
+func (s *IndeedScraper) searchPage(ctx context.Context, browserCtx playwright.BrowserContext, page playwright.Page, searchURL string, pager *scraper.Paginator, seenURLs map[string]bool, out chan<- scraper.Job) (int, int, error) {
+	resp, err := browser.Goto(ctx, page, searchURL, playwright.PageGotoOptions{
+		WaitUntil: playwright.WaitUntilStateDomcontentloaded,
+		Timeout:   playwright.Float(30000),
+	})
+	if err != nil {
+		log.Printf("    ⚠️ Navigation failed: %v", err)
+		return 0, 0, nil
+	}
+	browser.RandomDelay(1000, 2000)
+
+	//Indeed sits behind Cloudflare: the check usually passes on its own after a few seconds
+	if err := s.challenge.Check(ctx, page, resp); err != nil {
+		return 0, 0, fmt.Errorf("indeed: %w", err)
+	}
+
+	if count, _ := page.Locator(s.sel.CSS("search.no_results")).Count(); count > 0 {
+		log.Println("    ℹ️ No jobs found")
+		return 0, 0, nil
+	}
+
+	page.WaitForSelector(s.sel.CSS("search.card"), playwright.PageWaitForSelectorOptions{
+		Timeout: playwright.Float(5000),
+	})
+	browser.HumanScroll(page)
+
+	cards, err := s.sel.OnPage(page, "search.card").All()
+	if err != nil || len(cards) == 0 {
+		log.Println("    ℹ️ No job cards found")
+		return 0, 0, nil
+	}
+	log.Printf("    📦 Found %d cards", len(cards))
+
+	var wg sync.WaitGroup
+	results := make(chan scraper.Job, len(cards))
+	seenCards := 0
+	now := time.Now()
+
+	//card metadata is read sequentially (Playwright page is NOT thread-safe),
+	//detail pages are fetched concurrently in their own tabs
+	for _, card := range cards {
+		job, ok := s.parseCard(card, now)
+		if !ok {
+			continue
+		}
+		if pager.Seen(job.URL) {
+			seenCards++
+			continue
+		}
+		if seenURLs[job.URL] {
+			continue
+		}
+		seenURLs[job.URL] = true
+
+		//fast filters: no detail tab for Hanoi-only or senior jobs
+		if filter.AnalyzeLocation(job.Location).HanoiOnly() {
+			continue
+		}
+		if filter.IsExcludedLevel(job.Title) {
+			log.Printf("    ❌ Skipped (Fast Title): %s", job.Title)
+			continue
+		}
+
+		if !pager.TakeCard() {
+			log.Println("    🛑 Indeed card budget reached.")
+			break
+		}
+
+		wg.Add(1)
+		go func(job scraper.Job) {
+			defer wg.Done()
+			if detailed, err := s.details.Fetch(ctx, browserCtx, job.URL, s.readJobDetail(job)); err != nil {
+				log.Printf("      ⚠️ %v", err)
+			} else {
+				job = detailed
+			}
+			results <- job
+		}(job)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for job := range results {
+		if len([]rune(job.Description)) < 50 {
+			log.Printf("      ⚠️ Skipped (Empty/Failed Description): %s", job.Title)
+			continue
+		}
+		if !filter.ShouldIncludeJob(job) {
+			continue
+		}
+		if filter.AnalyzeLocation(job.Location + " " + job.Title + " " + job.Description).HanoiOnly() {
+			continue
+		}
+		log.Printf("      ✅ %s - %s", job.Title, job.Company)
+		if err := scraper.Send(ctx, out, job); err != nil {
+			return len(cards), seenCards, err
+		}
+	}
+	return len(cards), seenCards, nil
+}
+
+// parseCard reads a result card; false when it has no title or link
+func (s *IndeedScraper) parseCard(card playwright.Locator, now time.Time) (scraper.Job, bool) {
+	title, _ := s.sel.In(card, "card.title").First().TextContent(playwright.LocatorTextContentOptions{
+		Timeout: playwright.Float(1000),
+	})
+	link := s.sel.In(card, "card.link").First()
+	href, _ := link.GetAttribute("href", playwright.LocatorGetAttributeOptions{Timeout: playwright.Float(1000)})
+	jk, _ := link.GetAttribute("data-jk", playwright.LocatorGetAttributeOptions{Timeout: playwright.Float(1000)})
+
+	title = strings.TrimSpace(title)
+	jobLink := jobURL(jk, href)
+	if title == "" || jobLink == "" {
+		return scraper.Job{}, false
+	}
+
+	company := s.cardText(card, "card.company", "Unknown")
+	location := s.cardText(card, "card.location", "Vietnam")
+	salary := s.cardText(card, "card.salary", "Negotiable")
+	posted := s.cardText(card, "card.posted", "")
+
+	return scraper.Job{
+		Title:      title,
+		Company:    company,
+		URL:        jobLink,
+		Location:   location,
+		Salary:     salary,
+		Source:     "Indeed",
+		Techstack:  "Golang",
+		PostedDate: utils.PostedDate(posted, now),
+	}, true
+}
+
+// cardText returns the trimmed text of a card field, def when it is missing or empty
+func (s *IndeedScraper) cardText(card playwright.Locator, name, def string) string {
+	loc := s.sel.In(card, name)
+	if count, _ := loc.Count(); count == 0 {
+		return def
+	}
+	text, err := loc.First().InnerText(playwright.LocatorInnerTextOptions{Timeout: playwright.Float(1000)})
+	text = strings.Join(strings.Fields(text), " ")
+	if err != nil || text == "" {
+		return def
+	}
+	return text
+}
+
+// readJobDetail fills a copy of the card's job from the viewjob page (the content of the
+// detail pane); JSON-LD JobPosting first, the description panel text only when structured
+// data has none
+func (s *IndeedScraper) readJobDetail(job scraper.Job) scraper.ReadDetail {
+	return func(detailPage playwright.Page) (scraper.Job, error) {
+		//the card's relative date is more precise than Indeed's JSON-LD datePosted (often the first posting)
+		cardDate := job.PostedDate
+		if posting, ok := scraper.FindJobPosting(scraper.ExtractJobPostings(detailPage), job.Title); ok {
+			posting.Apply(&job)
+			if cardDate != "Recent" {
+				job.PostedDate = cardDate
+			}
+		}
+
+		if job.Description == "" {
+			text, err := s.sel.OnPage(detailPage, "detail.description").First().InnerText(playwright.LocatorInnerTextOptions{
+				Timeout: playwright.Float(5000),
+			})
+			if err == nil {
+				job.Description = truncate(strings.TrimSpace(text), 5000)
+			}
+		}
+
+		if job.Salary == "Negotiable" {
+			if count, _ := s.sel.OnPage(detailPage, "detail.salary").Count(); count > 0 {
+				if salary, err := s.sel.OnPage(detailPage, "detail.salary").First().TextContent(); err == nil && strings.TrimSpace(salary) != "" {
+					job.Salary = strings.TrimSpace(salary)
+				}
+			}
+		}
+		return job, nil
+	}
+}
@@ -0,0 +1,75 @@
+package indeed
+
+import (
+	"context"
+	"errors"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper/scrapertest"
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIndeedScraper_Scrape_Cloudflare verifies that a challenge that does not clear stops the scraper with ErrBlocked
+func TestIndeedScraper_Scrape_Cloudflare(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+
+	mockHTML := `<html><title>Just a moment...</title><body>Checking your browser</body></html>`
+	if err := browserCtx.Route("**/*", func(route playwright.Route) {
+		route.Fulfill(playwright.RouteFulfillOptions{
+			Status: playwright.Int(200),
+			Body:   mockHTML,
+		})
+	}); err != nil {
+		t.Fatalf("could not set up route interception: %v", err)
+	}
+
+	cfg := &config.Config{Keywords: []string{"golang"}}
+	cfg.Challenge = config.ChallengeRules{Wait: time.Second, RecheckInterval: 200 * time.Millisecond, EvidenceDir: t.TempDir()}
+	jobs, err := NewIndeedScraper(cfg).Scrape(context.Background(), browserCtx)
+
+	assert.True(t, errors.Is(err, browser.ErrBlocked), "got %v", err)
+	assert.Empty(t, jobs)
+}
+
+// TestIndeedScraper_Scrape_Replay serves recorded pages from testdata/replay (no network).
+// Page 1 has a DOM-only job, a JSON-LD job, a senior and a Hanoi card (skipped without
+// opening them) and a PHP job (dropped by the keyword filter); page 2 has no results.
+// Re-record with: SCRAPERTEST_RECORD=1 go test -run TestIndeedScraper_Scrape_Replay ./internal/scraper/indeed/
+func TestIndeedScraper_Scrape_Replay(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+	scrapertest.Attach(t, browserCtx, "testdata/replay", scrapertest.ModeFromEnv())
+
+	cfg := &config.Config{Keywords: []string{"golang"}, Locations: []string{"ho chi minh"}}
+	jobs, err := NewIndeedScraper(cfg).Scrape(context.Background(), browserCtx)
+
+	assert.NoError(t, err)
+	if !assert.Len(t, jobs, 2) {
+		return
+	}
+
+	byTitle := map[string]int{}
+	for i, job := range jobs {
+		byTitle[job.Title] = i
+	}
+	today := time.Now()
+
+	dom := jobs[byTitle["Junior Golang Developer"]]
+	assert.Equal(t, "ABC Tech", dom.Company)
+	assert.Equal(t, "https://vn.indeed.com/viewjob?jk=a1b2c3d4e5f60001", dom.URL)
+	assert.Equal(t, "Thành phố Hồ Chí Minh", dom.Location)
+	assert.Equal(t, "15.000.000 ₫ - 25.000.000 ₫ một tháng", dom.Salary)
+	assert.Equal(t, today.AddDate(0, 0, -3).Format("2006-01-02"), dom.PostedDate)
+	assert.Contains(t, dom.Description, "Build payment APIs in Golang")
+	assert.Equal(t, "Indeed", dom.Source)
+
+	structured := jobs[byTitle["Golang Fresher (Remote)"]]
+	assert.Equal(t, "Gopher Co", structured.Company)
+	assert.Equal(t, "Remote", structured.Location)
+	assert.Equal(t, "12.000.000 ₫ một tháng", structured.Salary, "salary from the detail page when the card has none")
+	assert.Equal(t, today.Format("2006-01-02"), structured.PostedDate, "card date wins over JSON-LD datePosted")
+	assert.Equal(t, "Join our remote team as a Golang fresher.\nYou will write gRPC services and tests.", structured.Description)
+}
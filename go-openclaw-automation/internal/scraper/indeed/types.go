@@ -0,0 +1,87 @@
+package indeed
+
+import (
+	"go-openclaw-automation/utils"
+	"strings"
+)
+
+// searchLocation is one value of the Indeed "l" parameter
+type searchLocation struct {
+	Param   string
+	Aliases []string //normalized config values that select it
+}
+
+// locations maps config locations to Indeed location params
+var locations = []searchLocation{
+	{Param: "Thành phố Hồ Chí Minh", Aliases: []string{"ho chi minh", "hcm", "sai gon", "saigon"}},
+	{Param: "Cần Thơ", Aliases: []string{"can tho"}},
+	{Param: "Remote", Aliases: []string{"remote", "tu xa", "online", "work from home"}},
+	{Param: "Hà Nội", Aliases: []string{"ha noi", "hanoi"}},
+	{Param: "Đà Nẵng", Aliases: []string{"da nang", "danang"}},
+}
+
+// defaultLocations is the Node scraper's combination when config has none we know
+var defaultLocations = []string{"Vietnam", "Cần Thơ", "Remote"}
+
+// searchLocations maps config locations to Indeed "l" values (deduplicated, in config order)
+func searchLocations(configured []string) []string {
+	var params []string
+	for _, loc := range configured {
+		normalized := utils.NormalizeText(loc)
+		for _, candidate := range locations {
+			if containsAny(normalized, candidate.Aliases) && !contains(params, candidate.Param) {
+				params = append(params, candidate.Param)
+			}
+		}
+	}
+	if len(params) == 0 {
+		return defaultLocations
+	}
+	return params
+}
+
+// jobURL returns the canonical viewjob link of a card: the tracking redirect
+// (/rc/clk?jk=...&from=...) and the viewjob link share the "jk" job key
+func jobURL(jk, href string) string {
+	if jk == "" {
+		if idx := strings.Index(href, "jk="); idx != -1 {
+			jk = href[idx+3:]
+			if end := strings.IndexAny(jk, "&#"); end != -1 {
+				jk = jk[:end]
+			}
+		}
+	}
+	if jk != "" {
+		return "https://vn.indeed.com/viewjob?jk=" + jk
+	}
+	if href != "" && !strings.HasPrefix(href, "http") {
+		return "https://vn.indeed.com" + href
+	}
+	return href
+}
+
+func containsAny(s string, subs []string) bool {
+	for _, sub := range subs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func truncate(s string, max int) string {
+	r := []rune(s)
+	if len(r) <= max {
+		return s
+	}
+	return string(r[:max])
+}
@@ -0,0 +1,26 @@
+package indeed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchLocations(t *testing.T) {
+	got := searchLocations([]string{"remote", "cần thơ", "can tho", "ho chi minh", "hcm", "sai gon", "ho chi minh city", "online"})
+	assert.Equal(t, []string{"Remote", "Cần Thơ", "Thành phố Hồ Chí Minh"}, got)
+
+	assert.Equal(t, []string{"Vietnam", "Cần Thơ", "Remote"}, searchLocations(nil), "Node defaults when nothing matches")
+}
+
+func TestJobURL(t *testing.T) {
+	assert.Equal(t, "https://vn.indeed.com/viewjob?jk=abc123", jobURL("abc123", "/rc/clk?jk=abc123&from=serp"))
+	assert.Equal(t, "https://vn.indeed.com/viewjob?jk=abc123", jobURL("", "/rc/clk?jk=abc123&from=serp"))
+	assert.Equal(t, "https://vn.indeed.com/pagead/clk?mo=r", jobURL("", "/pagead/clk?mo=r"))
+	assert.Equal(t, "", jobURL("", ""))
+}
+
+func TestSearchURL(t *testing.T) {
+	assert.Equal(t, "https://vn.indeed.com/jobs?q=golang&l=C%E1%BA%A7n+Th%C6%A1&sort=date", searchURL("golang", "Cần Thơ", 1))
+	assert.Equal(t, "https://vn.indeed.com/jobs?q=golang&l=Remote&sort=date&start=10", searchURL("golang", "Remote", 2))
+}
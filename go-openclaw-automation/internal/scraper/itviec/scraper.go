@@ -0,0 +1,407 @@
+package itviec
+
+import (
+	"context"
+	"fmt"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper"
+	"go-openclaw-automation/internal/scraper/selectors"
+	"go-openclaw-automation/utils"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+type ITViecScraper struct {
+	cfg       *config.Config
+	details   *scraper.DetailFetcher //tab pool for job pages
+	seen      scraper.SeenFunc       //jobs stored by previous runs (nil = none)
+	sel       *selectors.Pack
+	challenge *browser.ChallengeDetector
+}
+
+func init() {
+	scraper.Register("itviec", func(cfg *config.Config) scraper.Scraper {
+		return NewITViecScraper(cfg)
+	})
+}
+
+func NewITViecScraper(cfg *config.Config) *ITViecScraper {
+	sel := selectors.ForPlatform(cfg.SelectorsPath, "itviec")
+	return &ITViecScraper{
+		cfg:       cfg,
+		details:   scraper.NewDetailFetcher(0, 0),
+		sel:       sel,
+		challenge: scraper.NewChallengeDetector(cfg, "itviec", browser.ChallengeOptions{TurnstileCheckbox: sel.CSS("challenge.checkbox")}),
+	}
+}
+
+func (s *ITViecScraper) Name() string {
+	return "ITViec"
+}
+
+// SetSeen lets pagination stop early on pages that only hold already-seen jobs
+func (s *ITViecScraper) SetSeen(seen scraper.SeenFunc) {
+	s.seen = seen
+}
+
+// SetDetailFetcher shares the runner's tab pool
+func (s *ITViecScraper) SetDetailFetcher(details *scraper.DetailFetcher) {
+	s.details = details
+}
+
+func (s *ITViecScraper) Scrape(ctx context.Context, browserCtx playwright.BrowserContext) ([]scraper.Job, error) {
+	return scraper.Collect(ctx, s, browserCtx)
+}
+
+// ScrapeStream sends each job to out as soon as its card is processed
+func (s *ITViecScraper) ScrapeStream(ctx context.Context, browserCtx playwright.BrowserContext, out chan<- scraper.Job) error {
+	//dedup by URL across keywords and locations
+	seenURLs := make(map[string]bool)
+
+	//init page
+	page, err := browserCtx.NewPage()
+	if err != nil {
+		return fmt.Errorf("itviec: failed to create page: %w", err)
+	}
+	defer page.Close()
+
+	//configure locations mapping
+	locations := []struct {
+		Slug string
+		Name string
+	}{
+		{
+			Slug: "ho-chi-minh-hcm",
+			Name: "Ho Chi Minh",
+		},
+		{
+			Slug: "can-tho",
+			Name: "Can Tho",
+		},
+	}
+
+	//page and card budget shared by every search of this run
+	pager := scraper.PaginatorFor(s.cfg, "itviec", s.seen)
+
+	for _, keyword := range s.cfg.Keywords {
+		//Slugify keyword: "golang developer" => "golang-developer"
+		keywordSlug := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(keyword)), " ", "-")
+		for _, loc := range locations {
+			//check context cancellation
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if pager.Exhausted() {
+				log.Println("    🛑 ITViec card budget reached.")
+				return nil
+			}
+
+			url := fmt.Sprintf("https://itviec.com/it-jobs/%s/%s", keywordSlug, loc.Slug)
+			log.Printf("  🔍 Searching: %s - %s (Applying UI Filter)", keyword, loc.Name)
+
+			//navigate
+			resp, err := browser.Goto(ctx, page, url, playwright.PageGotoOptions{
+				WaitUntil: playwright.WaitUntilStateDomcontentloaded,
+				Timeout:   playwright.Float(30000),
+			})
+			if err != nil {
+				log.Printf("    ⚠️ Navigation failed: %v", err)
+				continue
+			}
+
+			//antibot check: stop scraping if blocked
+			if err := s.challenge.Check(ctx, page, resp); err != nil {
+				return fmt.Errorf("itviec: %w", err)
+			}
+
+			//the filter bar renders after the results
+			s.sel.OnPage(page, "filter.level_dropdown").First().WaitFor(playwright.LocatorWaitForOptions{
+				State:   playwright.WaitForSelectorStateVisible,
+				Timeout: playwright.Float(15000),
+			})
+
+			//UI filter interaction
+			if err := s.applyFresherFilter(page); err != nil {
+				log.Printf("    ⚠️ UI Filter Error: %v", err)
+				// Continue scraping even if filter fails, but warn
+			}
+
+			//follow the "next" link so the UI filter stays applied; results are newest first
+			for pageNum := 1; pager.HasPage(pageNum); pageNum++ {
+				cards, seenCards, err := s.processPage(ctx, browserCtx, page, keyword, pager, seenURLs, out)
+				if err != nil {
+					return err
+				}
+				if pager.Done(cards, seenCards) {
+					break
+				}
+
+				nextURL := s.nextPageURL(page)
+				if nextURL == "" || !pager.HasPage(pageNum+1) {
+					break
+				}
+				log.Printf("    ➡️ Page %d: %s", pageNum+1, nextURL)
+				resp, err := browser.Goto(ctx, page, nextURL, playwright.PageGotoOptions{
+					WaitUntil: playwright.WaitUntilStateDomcontentloaded,
+					Timeout:   playwright.Float(30000),
+				})
+				if err != nil {
+					log.Printf("    ⚠️ Navigation failed: %v", err)
+					break
+				}
+				browser.RandomDelay(2000, 4000)
+				if err := s.challenge.Check(ctx, page, resp); err != nil {
+					return fmt.Errorf("itviec: %w", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// processPage streams the jobs of the results page currently loaded.
+// Cards are read in order, their job pages are fetched concurrently through the tab pool.
+// It returns how many cards the page has and how many of them were already seen.
+func (s *ITViecScraper) processPage(ctx context.Context, browserCtx playwright.BrowserContext, page playwright.Page, keyword string, pager *scraper.Paginator, seenURLs map[string]bool, out chan<- scraper.Job) (int, int, error) {
+	//Check empty state
+	if visible, _ := s.sel.OnPage(page, "search.empty").IsVisible(); visible {
+		log.Printf("    ⚠️ No jobs found (Empty State)")
+		return 0, 0, nil
+	}
+
+	//get job cards
+	page.WaitForSelector(s.sel.CSS("search.card"), playwright.PageWaitForSelectorOptions{
+		Timeout: playwright.Float(3000),
+	})
+	cards, err := s.sel.OnPage(page, "search.card").All()
+	if err != nil {
+		log.Printf("    ⚠️ Error getting job cards: %v", err)
+		return 0, 0, nil
+	}
+	log.Printf("    📦 Found %d job cards", len(cards))
+
+	var wg sync.WaitGroup
+	results := make(chan scraper.Job, len(cards))
+
+	//card metadata is read sequentially (Playwright page is NOT thread-safe)
+	seenCards := 0
+	for _, card := range cards {
+		job, err := s.readJobCard(card)
+		if err != nil {
+			continue
+		}
+		//already stored by a previous run: no need to open its job page
+		if pager.Seen(job.URL) {
+			seenCards++
+			continue
+		}
+		if seenURLs[job.URL] {
+			continue
+		}
+		seenURLs[job.URL] = true
+
+		//card budget for the whole run
+		if !pager.TakeCard() {
+			log.Println("    🛑 ITViec card budget reached.")
+			break
+		}
+
+		wg.Add(1)
+		go func(job scraper.Job) {
+			defer wg.Done()
+			if detailed, err := s.details.Fetch(ctx, browserCtx, job.URL, s.readJobDetail(job)); err != nil {
+				log.Printf("      ⚠️ %v", err)
+			} else {
+				job = detailed
+			}
+			results <- job
+		}(job)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	//results is buffered for every card, so returning early never blocks the goroutines
+	for job := range results {
+		//check keyword presence in title/desc
+		kLower := strings.ToLower(keyword)
+		if !strings.Contains(strings.ToLower(job.Title), kLower) && !strings.Contains(strings.ToLower(job.Description), kLower) {
+			continue
+		}
+		log.Printf("      ✅ %s - %s", job.Title, job.Company)
+		if err := scraper.Send(ctx, out, job); err != nil {
+			return len(cards), seenCards, err
+		}
+	}
+	return len(cards), seenCards, nil
+}
+
+// nextPageURL returns the absolute URL of the pagination "next" link, empty on the last page
+func (s *ITViecScraper) nextPageURL(page playwright.Page) string {
+	next := s.sel.OnPage(page, "search.next_page").First()
+	if count, _ := next.Count(); count == 0 {
+		return ""
+	}
+	href, err := next.GetAttribute("href")
+	if err != nil || href == "" || href == "#" {
+		return ""
+	}
+	if strings.HasPrefix(href, "/") {
+		href = "https://itviec.com" + href
+	}
+	return href
+}
+
+// applyFresherFilter interacts with the UI to select Fresher level
+func (s *ITViecScraper) applyFresherFilter(page playwright.Page) error {
+	dropdown := s.sel.OnPage(page, "filter.level_dropdown")
+	if visible, _ := dropdown.IsVisible(); visible {
+		dropdown.Click()
+		time.Sleep(1 * time.Second)
+
+		//Select fresher
+		fresherInput := s.sel.OnPage(page, "filter.fresher_input")
+		fresherLabel := page.Locator(s.sel.CSS("filter.fresher_label"))
+		clicked := false
+		if count, _ := fresherInput.Count(); count > 0 {
+			if err := fresherInput.First().Click(playwright.LocatorClickOptions{
+				Force: playwright.Bool(true),
+			}); err == nil {
+				clicked = true
+			}
+		}
+		if !clicked {
+			if count, _ := fresherLabel.Count(); count > 0 {
+				if err := fresherLabel.First().Click(playwright.LocatorClickOptions{
+					Force: playwright.Bool(true),
+				}); err == nil {
+					clicked = true
+				}
+			}
+		}
+		if clicked {
+			log.Println("    🔽 UI Filter Applied: Fresher")
+			// Wait for network idle (simulated)
+			time.Sleep(2 * time.Second)
+			//close dropdown
+			page.Locator("body").Click(playwright.LocatorClickOptions{
+				Force:    playwright.Bool(true),
+				Position: &playwright.Position{X: 1, Y: 1},
+			})
+			//verify
+			badge := s.sel.OnPage(page, "filter.counter").First()
+			if visible, _ := badge.IsVisible(); visible {
+				text, _ := badge.TextContent()
+				if strings.TrimSpace(text) == "1" {
+					log.Println("    ✅ Filter verification success: 1 active filter confirmed.")
+					return nil
+				}
+			}
+			return fmt.Errorf("filter verification failed")
+		}
+		return fmt.Errorf("failed to click Fresher option")
+	}
+	log.Println("    ℹ️ Level dropdown not found, skipping filter.")
+	return nil
+}
+
+// readJobCard reads the card's basic info and job link without opening it
+func (s *ITViecScraper) readJobCard(card playwright.Locator) (scraper.Job, error) {
+	title, err := s.sel.In(card, "card.title").First().TextContent()
+	if err != nil {
+		return scraper.Job{}, err
+	}
+
+	company, _ := s.sel.In(card, "card.company").First().TextContent()
+	salary, _ := s.sel.In(card, "card.salary").First().TextContent()
+	if salary == "" {
+		salary = "Negotiable"
+	}
+	location, _ := s.sel.In(card, "card.location").Last().TextContent()
+
+	//"Posted 3 days ago" / "Đăng 2 giờ trước"
+	postedDate := "Recent"
+	if count, _ := s.sel.In(card, "card.posted").Count(); count > 0 {
+		if posted, err := s.sel.In(card, "card.posted").First().TextContent(); err == nil {
+			postedDate = utils.PostedDate(posted, time.Now())
+		}
+	}
+
+	jobURL := s.cardURL(card)
+	if jobURL == "" {
+		return scraper.Job{}, fmt.Errorf("no job link on card %q", strings.TrimSpace(title))
+	}
+
+	return scraper.Job{
+		Title:      strings.TrimSpace(title),
+		Company:    strings.TrimSpace(company),
+		URL:        jobURL,
+		Salary:     strings.TrimSpace(salary),
+		Location:   strings.TrimSpace(location),
+		Source:     "ITViec",
+		Techstack:  "Golang",
+		PostedDate: postedDate,
+	}, nil
+}
+
+// cardURL returns the card's absolute job link without query params (empty when missing).
+// ITviec keeps it in data attributes of the card or its title rather than in an <a>.
+func (s *ITViecScraper) cardURL(card playwright.Locator) string {
+	opts := playwright.LocatorGetAttributeOptions{Timeout: playwright.Float(500)}
+	candidates := []func() (string, error){
+		func() (string, error) { return card.GetAttribute("data-url", opts) },
+		func() (string, error) { return s.sel.In(card, "card.title").First().GetAttribute("data-url", opts) },
+		func() (string, error) { return card.GetAttribute("data-search--job-selection-job-url-value", opts) },
+	}
+
+	for _, get := range candidates {
+		href, err := get()
+		if href = strings.TrimSpace(href); err != nil || href == "" {
+			continue
+		}
+		if idx := strings.Index(href, "?"); idx != -1 {
+			href = href[:idx]
+		}
+		if strings.HasPrefix(href, "/") {
+			href = "https://itviec.com" + href
+		}
+		return href
+	}
+	return ""
+}
+
+// readJobDetail fills a copy of the card's job from its job page:
+// JSON-LD JobPosting first, the description and skills sections otherwise
+func (s *ITViecScraper) readJobDetail(job scraper.Job) scraper.ReadDetail {
+	return func(detailPage playwright.Page) (scraper.Job, error) {
+		if posting, ok := scraper.FindJobPosting(scraper.ExtractJobPostings(detailPage), job.Title); ok {
+			posting.Apply(&job)
+		}
+		if job.Description != "" {
+			return job, nil
+		}
+
+		var parts []string
+		for _, name := range []string{"detail.description", "detail.skills"} {
+			text, err := s.sel.OnPage(detailPage, name).First().InnerText(playwright.LocatorInnerTextOptions{
+				Timeout: playwright.Float(5000),
+			})
+			if err == nil {
+				if text = strings.TrimSpace(text); text != "" {
+					parts = append(parts, text)
+				}
+			}
+		}
+
+		job.Description = strings.Join(parts, "\n\n")
+		return job, nil
+	}
+}
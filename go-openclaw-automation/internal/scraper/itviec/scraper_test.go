@@ -0,0 +1,37 @@
+package itviec
+
+import (
+	"context"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper/scrapertest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestITViecScraper_Scrape_Replay serves recorded pages from testdata/replay (no network).
+// HCM has one Golang card and one PHP card (dropped by the keyword check once its job page is read),
+// Can Tho is the empty state.
+// Re-record with: SCRAPERTEST_RECORD=1 go test -run TestITViecScraper_Scrape_Replay ./internal/scraper/itviec/
+func TestITViecScraper_Scrape_Replay(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+	scrapertest.Attach(t, browserCtx, "testdata/replay", scrapertest.ModeFromEnv())
+
+	cfg := &config.Config{Keywords: []string{"golang"}}
+	scraper := NewITViecScraper(cfg)
+
+	jobs, err := scraper.Scrape(context.Background(), browserCtx)
+
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 1) {
+		job := jobs[0]
+		assert.Equal(t, "Junior Golang Developer", job.Title)
+		assert.Equal(t, "ABC Tech", job.Company)
+		assert.Equal(t, "1,000 - 1,500 USD", job.Salary)
+		assert.Equal(t, "Ho Chi Minh", job.Location)
+		assert.Equal(t, "https://itviec.com/it-jobs/junior-golang-developer-abc-tech-1234", job.URL, "query params should be stripped")
+		assert.Equal(t, time.Now().AddDate(0, 0, -3).Format("2006-01-02"), job.PostedDate)
+		assert.Equal(t, "Build backend services in Golang.\n\nDocker, PostgreSQL", job.Description)
+	}
+}
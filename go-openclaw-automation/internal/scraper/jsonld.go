@@ -0,0 +1,343 @@
+// schema.org JobPosting (JSON-LD) extractor
+// Detail-page fetchers try structured data first, DOM text only fills the gaps
+
+package scraper
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+var (
+	htmlBreakRegex = regexp.MustCompile(`(?i)<\s*(br|/p|/li|/div|/h[1-6])\s*/?>`)
+	htmlTagRegex   = regexp.MustCompile(`<[^>]*>`)
+	blankLineRegex = regexp.MustCompile(`\n\s*\n+`)
+	isoDatePrefix  = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}`)
+)
+
+// JobPosting is the subset of schema.org/JobPosting the pipeline uses
+type JobPosting struct {
+	Title       string
+	Company     string
+	Location    string
+	Description string //plain text, HTML stripped
+	URL         string
+	DatePosted  string //YYYY-MM-DD when parseable, raw value otherwise
+	Remote      bool   //jobLocationType TELECOMMUTE
+
+	//baseSalary, zero when absent
+	SalaryMin      float64
+	SalaryMax      float64
+	SalaryCurrency string
+	SalaryUnit     string //HOUR, DAY, WEEK, MONTH, YEAR
+}
+
+// ExtractJobPostings reads every JSON-LD JobPosting embedded in the page
+func ExtractJobPostings(page playwright.Page) []JobPosting {
+	scripts, err := page.Locator(`script[type="application/ld+json"]`).AllTextContents()
+	if err != nil {
+		return nil
+	}
+	return ParseJobPostings(scripts)
+}
+
+// ParseJobPostings decodes JSON-LD script bodies. Objects, arrays and @graph containers
+// are walked; blocks that are not valid JSON or not a JobPosting are skipped.
+func ParseJobPostings(scripts []string) []JobPosting {
+	var postings []JobPosting
+	for _, script := range scripts {
+		var data any
+		if err := json.Unmarshal([]byte(strings.TrimSpace(script)), &data); err != nil {
+			continue
+		}
+		for _, node := range jsonLDNodes(data) {
+			if isJobPosting(node) {
+				postings = append(postings, parseJobPosting(node))
+			}
+		}
+	}
+	return postings
+}
+
+// FindJobPosting picks the posting for a job title (case-insensitive).
+// A page with a single posting is assumed to describe the job being fetched.
+func FindJobPosting(postings []JobPosting, title string) (JobPosting, bool) {
+	for _, p := range postings {
+		if strings.EqualFold(strings.TrimSpace(p.Title), strings.TrimSpace(title)) {
+			return p, true
+		}
+	}
+	if len(postings) == 1 {
+		return postings[0], true
+	}
+	return JobPosting{}, false
+}
+
+// Apply fills job from the structured data: every field the posting has wins over DOM text
+func (p JobPosting) Apply(job *Job) {
+	if p.Title != "" {
+		job.Title = p.Title
+	}
+	if p.Company != "" {
+		job.Company = p.Company
+	}
+	if p.Location != "" {
+		job.Location = p.Location
+	}
+	if p.Description != "" {
+		job.Description = p.Description
+	}
+	if p.DatePosted != "" {
+		job.PostedDate = p.DatePosted
+	}
+	if salary := p.SalaryText(); salary != "" {
+		job.Salary = salary
+	}
+}
+
+// SalaryText renders baseSalary as "min - max CURRENCY/unit" (empty when absent).
+// One-sided ranges read "From min" / "Up to max" so ParseSalary keeps them open-ended.
+func (p JobPosting) SalaryText() string {
+	if p.SalaryMin == 0 && p.SalaryMax == 0 {
+		return ""
+	}
+
+	var amount string
+	switch {
+	case p.SalaryMin == p.SalaryMax:
+		amount = formatAmount(p.SalaryMin)
+	case p.SalaryMin > 0 && p.SalaryMax > 0:
+		amount = formatAmount(p.SalaryMin) + " - " + formatAmount(p.SalaryMax)
+	case p.SalaryMax > 0:
+		amount = "Up to " + formatAmount(p.SalaryMax)
+	default:
+		amount = "From " + formatAmount(p.SalaryMin)
+	}
+
+	if p.SalaryCurrency != "" {
+		amount += " " + p.SalaryCurrency
+	}
+	if p.SalaryUnit != "" {
+		amount += "/" + strings.ToLower(p.SalaryUnit)
+	}
+	return amount
+}
+
+// jsonLDNodes flattens arrays and @graph containers into a list of objects
+func jsonLDNodes(data any) []map[string]any {
+	switch v := data.(type) {
+	case []any:
+		var nodes []map[string]any
+		for _, item := range v {
+			nodes = append(nodes, jsonLDNodes(item)...)
+		}
+		return nodes
+	case map[string]any:
+		nodes := []map[string]any{v}
+		if graph, ok := v["@graph"]; ok {
+			nodes = append(nodes, jsonLDNodes(graph)...)
+		}
+		return nodes
+	}
+	return nil
+}
+
+func isJobPosting(node map[string]any) bool {
+	switch t := node["@type"].(type) {
+	case string:
+		return t == "JobPosting"
+	case []any:
+		for _, item := range t {
+			if s, ok := item.(string); ok && s == "JobPosting" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func parseJobPosting(node map[string]any) JobPosting {
+	p := JobPosting{
+		Title:       cleanText(stringField(node, "title")),
+		Company:     cleanText(nameOf(node["hiringOrganization"])),
+		Location:    parseLocations(node["jobLocation"]),
+		Description: HTMLToText(stringField(node, "description")),
+		URL:         stringField(node, "url"),
+		DatePosted:  normalizeISODate(stringField(node, "datePosted")),
+		Remote:      strings.EqualFold(stringField(node, "jobLocationType"), "TELECOMMUTE"),
+	}
+
+	if p.Remote {
+		if p.Location == "" {
+			p.Location = "Remote"
+		} else {
+			p.Location += "; Remote"
+		}
+	}
+
+	//baseSalary: MonetaryAmount{currency, value: QuantitativeValue{minValue, maxValue, value, unitText}}
+	if salary, ok := node["baseSalary"].(map[string]any); ok {
+		p.SalaryCurrency = stringField(salary, "currency")
+		switch value := salary["value"].(type) {
+		case map[string]any:
+			p.SalaryMin = numberField(value, "minValue")
+			p.SalaryMax = numberField(value, "maxValue")
+			if exact := numberField(value, "value"); exact > 0 && p.SalaryMin == 0 && p.SalaryMax == 0 {
+				p.SalaryMin, p.SalaryMax = exact, exact
+			}
+			p.SalaryUnit = strings.ToUpper(stringField(value, "unitText"))
+		default:
+			if exact := toNumber(value); exact > 0 {
+				p.SalaryMin, p.SalaryMax = exact, exact
+			}
+		}
+		if p.SalaryUnit == "" {
+			p.SalaryUnit = strings.ToUpper(stringField(salary, "unitText"))
+		}
+	}
+
+	return p
+}
+
+// parseLocations joins one or many Place/PostalAddress values into "City, Region; City2"
+func parseLocations(v any) string {
+	var places []string
+	switch loc := v.(type) {
+	case []any:
+		for _, item := range loc {
+			if place := parseLocations(item); place != "" {
+				places = append(places, place)
+			}
+		}
+		return strings.Join(places, "; ")
+	case string:
+		return cleanText(loc)
+	case map[string]any:
+		address := loc["address"]
+		if address == nil {
+			address = loc
+		}
+		switch addr := address.(type) {
+		case string:
+			return cleanText(addr)
+		case map[string]any:
+			var parts []string
+			for _, key := range []string{"addressLocality", "addressRegion"} {
+				part := cleanText(stringField(addr, key))
+				if part != "" && !containsFold(parts, part) {
+					parts = append(parts, part)
+				}
+			}
+			if len(parts) == 0 {
+				if street := cleanText(stringField(addr, "streetAddress")); street != "" {
+					parts = append(parts, street)
+				}
+			}
+			if len(parts) == 0 {
+				if country := cleanText(nameOf(addr["addressCountry"])); country != "" {
+					parts = append(parts, country)
+				}
+			}
+			return strings.Join(parts, ", ")
+		}
+	}
+	return ""
+}
+
+// HTMLToText strips tags from an HTML fragment, keeping paragraph and list breaks
+func HTMLToText(s string) string {
+	if s == "" {
+		return ""
+	}
+	s = htmlBreakRegex.ReplaceAllString(s, "\n")
+	s = htmlTagRegex.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+	s = strings.Join(lines, "\n")
+	s = blankLineRegex.ReplaceAllString(s, "\n\n")
+	return strings.TrimSpace(s)
+}
+
+// normalizeISODate turns "2026-10-01T08:00:00+07:00" into "2026-10-01"
+func normalizeISODate(s string) string {
+	s = strings.TrimSpace(s)
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.Format("2006-01-02")
+		}
+	}
+	if len(s) >= 10 && isoDatePrefix.MatchString(s) {
+		return s[:10]
+	}
+	return s
+}
+
+// nameOf reads an Organization/Country that may be a plain string or {"name": ...}
+func nameOf(v any) string {
+	switch o := v.(type) {
+	case string:
+		return o
+	case map[string]any:
+		return stringField(o, "name")
+	}
+	return ""
+}
+
+func stringField(node map[string]any, key string) string {
+	switch v := node[key].(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return ""
+}
+
+func numberField(node map[string]any, key string) float64 {
+	return toNumber(node[key])
+}
+
+// toNumber accepts JSON numbers and numeric strings ("15000000", "1,500")
+func toNumber(v any) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case string:
+		f, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(n), ",", ""), 64)
+		if err == nil {
+			return f
+		}
+	}
+	return 0
+}
+
+func formatAmount(f float64) string {
+	if f == float64(int64(f)) {
+		return strconv.FormatInt(int64(f), 10)
+	}
+	return fmt.Sprintf("%.2f", f)
+}
+
+func cleanText(s string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(s)), " ")
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
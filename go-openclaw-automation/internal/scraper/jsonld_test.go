@@ -0,0 +1,87 @@
+package scraper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJobPostings(t *testing.T) {
+	scripts := []string{
+		`{"@context": "https://schema.org", "@type": "Organization", "name": "Not a job"}`,
+		`not json at all`,
+		`{
+			"@context": "https://schema.org/",
+			"@type": "JobPosting",
+			"title": "Junior Golang Developer",
+			"description": "<p>Build <b>microservices</b> in Go.</p><ul><li>Docker</li><li>gRPC</li></ul>",
+			"datePosted": "2026-10-01T08:00:00+07:00",
+			"hiringOrganization": {"@type": "Organization", "name": "ABC Tech &amp; Co"},
+			"jobLocation": [
+				{"@type": "Place", "address": {"@type": "PostalAddress", "addressLocality": "Quận 1", "addressRegion": "Hồ Chí Minh", "addressCountry": "VN"}},
+				{"@type": "Place", "address": {"@type": "PostalAddress", "addressRegion": "Cần Thơ"}}
+			],
+			"baseSalary": {"@type": "MonetaryAmount", "currency": "VND", "value": {"@type": "QuantitativeValue", "minValue": 10000000, "maxValue": "20000000", "unitText": "MONTH"}}
+		}`,
+		`{"@graph": [{"@type": ["JobPosting"], "title": "Go Intern", "hiringOrganization": "XYZ", "jobLocationType": "TELECOMMUTE", "datePosted": "2026-09-30"}]}`,
+	}
+
+	postings := ParseJobPostings(scripts)
+	if !assert.Len(t, postings, 2) {
+		return
+	}
+
+	first := postings[0]
+	assert.Equal(t, "Junior Golang Developer", first.Title)
+	assert.Equal(t, "ABC Tech & Co", first.Company)
+	assert.Equal(t, "Quận 1, Hồ Chí Minh; Cần Thơ", first.Location)
+	assert.Equal(t, "Build microservices in Go.\nDocker\ngRPC", first.Description)
+	assert.Equal(t, "2026-10-01", first.DatePosted)
+	assert.Equal(t, "10000000 - 20000000 VND/month", first.SalaryText())
+
+	second := postings[1]
+	assert.Equal(t, "Go Intern", second.Title)
+	assert.Equal(t, "XYZ", second.Company)
+	assert.True(t, second.Remote)
+	assert.Equal(t, "Remote", second.Location)
+	assert.Equal(t, "", second.SalaryText())
+}
+
+func TestFindJobPosting(t *testing.T) {
+	postings := []JobPosting{{Title: "Golang Developer"}, {Title: "Java Developer"}}
+
+	p, ok := FindJobPosting(postings, " java developer ")
+	assert.True(t, ok)
+	assert.Equal(t, "Java Developer", p.Title)
+
+	_, ok = FindJobPosting(postings, "PHP Developer")
+	assert.False(t, ok)
+
+	p, ok = FindJobPosting(postings[:1], "Something else")
+	assert.True(t, ok, "a single posting describes the page")
+	assert.Equal(t, "Golang Developer", p.Title)
+}
+
+func TestJobPosting_Apply(t *testing.T) {
+	job := Job{Title: "Golang Dev", Company: "DOM Co", Salary: "Negotiable", PostedDate: "Recent", Description: "dom text"}
+	JobPosting{Company: "Structured Co", DatePosted: "2026-10-01", SalaryMax: 1500, SalaryCurrency: "USD"}.Apply(&job)
+
+	assert.Equal(t, "Golang Dev", job.Title, "empty structured fields keep the DOM value")
+	assert.Equal(t, "Structured Co", job.Company)
+	assert.Equal(t, "2026-10-01", job.PostedDate)
+	assert.Equal(t, "Up to 1500 USD", job.Salary)
+	assert.Equal(t, "dom text", job.Description)
+}
+
+func TestJobPosting_SalaryText_OneSided(t *testing.T) {
+	from := JobPosting{SalaryMin: 15_000_000, SalaryCurrency: "VND", SalaryUnit: "MONTH"}.SalaryText()
+	assert.Equal(t, "From 15000000 VND/month", from)
+	assert.Equal(t, Salary{Min: 15_000_000, Currency: "VND", Period: PeriodMonth}, ParseSalary(from))
+
+	upTo := JobPosting{SalaryMax: 1500, SalaryCurrency: "USD"}.SalaryText()
+	assert.Equal(t, "Up to 1500 USD", upTo)
+	assert.Equal(t, Salary{Max: 1500, Currency: "USD", Period: PeriodMonth}, ParseSalary(upTo))
+
+	exact := JobPosting{SalaryMin: 800, SalaryMax: 800, SalaryCurrency: "USD"}.SalaryText()
+	assert.Equal(t, "800 USD", exact)
+}
@@ -0,0 +1,397 @@
+// Search LinkedIn with the saved cookies
+// Jobs mode: job search built from config, job details read in a pool of tabs
+// Posts mode: recent hiring posts from the content search
+// Return jobs
+
+package linkedin
+
+import (
+	"context"
+	"fmt"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/filter"
+	"go-openclaw-automation/internal/scraper"
+	"go-openclaw-automation/internal/scraper/selectors"
+	"go-openclaw-automation/utils"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// Post search limits of the Node scraper: scan the newest posts of a keyword, keep a few
+const (
+	maxPostsScanned    = 8
+	maxPostsPerKeyword = 4
+	//posts older than this were seen by an earlier run (the Node runner runs every few hours)
+	maxPostAge = 8 * time.Hour
+)
+
+type LinkedInScraper struct {
+	cfg       *config.Config
+	details   *scraper.DetailFetcher //tab pool for job view pages
+	seen      scraper.SeenFunc       //jobs stored by previous runs (nil = none)
+	sel       *selectors.Pack
+	challenge *browser.ChallengeDetector
+}
+
+func init() {
+	scraper.Register("linkedin", func(cfg *config.Config) scraper.Scraper {
+		return NewLinkedInScraper(cfg)
+	})
+}
+
+func NewLinkedInScraper(cfg *config.Config) *LinkedInScraper {
+	sel := selectors.ForPlatform(cfg.SelectorsPath, "linkedin")
+	return &LinkedInScraper{
+		cfg:       cfg,
+		details:   scraper.NewDetailFetcher(0, 0),
+		sel:       sel,
+		challenge: scraper.NewChallengeDetector(cfg, "linkedin", browser.ChallengeOptions{LoginSelector: sel.CSS("detail.auth_wall")}),
+	}
+}
+
+func (s *LinkedInScraper) Name() string {
+	return "LinkedIn"
+}
+
+// SetSeen lets pagination stop early on pages that only hold already-seen jobs
+func (s *LinkedInScraper) SetSeen(seen scraper.SeenFunc) {
+	s.seen = seen
+}
+
+// SetDetailFetcher shares the runner's tab pool
+func (s *LinkedInScraper) SetDetailFetcher(details *scraper.DetailFetcher) {
+	s.details = details
+}
+
+func (s *LinkedInScraper) Scrape(ctx context.Context, browserCtx playwright.BrowserContext) ([]scraper.Job, error) {
+	return scraper.Collect(ctx, s, browserCtx)
+}
+
+// ScrapeStream runs the enabled modes for every keyword and sends each job once it is read.
+// A failed login check (expired cookies) stops the run with an error.
+func (s *LinkedInScraper) ScrapeStream(ctx context.Context, browserCtx playwright.BrowserContext, out chan<- scraper.Job) error {
+	log.Printf("💼 Searching LinkedIn (Authenticated)... (selectors v%s)", s.sel.Version)
+	seenURLs := make(map[string]bool)
+	opts := searchOptions(s.cfg)
+
+	page, err := browserCtx.NewPage()
+	if err != nil {
+		return fmt.Errorf("linkedin: failed to create page: %w", err)
+	}
+	defer page.Close()
+
+	if err := s.warmUp(ctx, page); err != nil {
+		return err
+	}
+
+	//page and card budget shared by every search of this run
+	pager := scraper.PaginatorFor(s.cfg, "linkedin", s.seen)
+
+	for _, keyword := range s.cfg.Keywords {
+		log.Printf("  🔑 Processing Keyword: %q", keyword)
+
+		if hasMode(opts, "jobs") {
+			for pageNum := 1; pager.HasPage(pageNum); pageNum++ {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				cards, seenCards, err := s.searchPage(ctx, browserCtx, page, searchURL(keyword, opts, pageNum), pager, seenURLs, out)
+				if err != nil {
+					return err
+				}
+				if pager.Done(cards, seenCards) {
+					break
+				}
+			}
+		}
+
+		if hasMode(opts, "posts") {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err := s.searchPosts(ctx, page, keyword, seenURLs, out); err != nil {
+				return err
+			}
+		}
+		browser.RandomDelay(2000, 3000)
+	}
+	return nil
+}
+
+// warmUp opens the feed like a returning user and checks that the cookies are still logged in
+func (s *LinkedInScraper) warmUp(ctx context.Context, page playwright.Page) error {
+	log.Println("  🏠 Navigating to LinkedIn Feed for warm-up...")
+	resp, err := browser.Goto(ctx, page, "https://www.linkedin.com/feed/", playwright.PageGotoOptions{
+		WaitUntil: playwright.WaitUntilStateDomcontentloaded,
+		Timeout:   playwright.Float(30000),
+	})
+	if err != nil {
+		return fmt.Errorf("linkedin: failed to load feed: %w", err)
+	}
+	//expired cookies redirect the feed to the authwall/login page
+	if err := s.challenge.Check(ctx, page, resp); err != nil {
+		return fmt.Errorf("linkedin: feed: %w", err)
+	}
+
+	if _, err := page.WaitForSelector(s.sel.CSS("nav.logged_in"), playwright.PageWaitForSelectorOptions{
+		Timeout: playwright.Float(10000),
+	}); err != nil {
+		return fmt.Errorf("linkedin: login verification failed, global nav not found: %w", browser.ErrLoginRequired)
+	}
+	log.Println("  ✅ Login confirmed.")
+
+	browser.RandomDelay(2000, 4000)
+	browser.MouseJiggle(page)
+	return nil
+}
+
+// searchPage loads one job search page and streams its jobs.
+// It returns the number of cards on the page and how many of them were already seen.
+func (s *LinkedInScraper) searchPage(ctx context.Context, browserCtx playwright.BrowserContext, page playwright.Page, searchURL string, pager *scraper.Paginator, seenURLs map[string]bool, out chan<- scraper.Job) (int, int, error) {
+	log.Printf("    🌐 Visiting Job Search: %s", searchURL)
+	resp, err := browser.Goto(ctx, page, searchURL, playwright.PageGotoOptions{
+		WaitUntil: playwright.WaitUntilStateDomcontentloaded,
+		Timeout:   playwright.Float(30000),
+	})
+	if err != nil {
+		log.Printf("    ⚠️ Failed to load job search page: %v", err)
+		return 0, 0, nil
+	}
+	if err := s.challenge.Check(ctx, page, resp); err != nil {
+		return 0, 0, fmt.Errorf("linkedin: job search: %w", err)
+	}
+
+	if _, err := page.WaitForSelector(s.sel.CSS("search.list"), playwright.PageWaitForSelectorOptions{
+		Timeout: playwright.Float(15000),
+	}); err != nil {
+		if count, _ := page.Locator(s.sel.CSS("search.no_results")).Count(); count > 0 {
+			log.Println("    ℹ️ No jobs found")
+		} else {
+			utils.NewScreenShotDebugger().CaptureAndLog(page, "linkedin-job-list-missing", "⚠️ LinkedIn: job list missing")
+		}
+		return 0, 0, nil
+	}
+	browser.RandomDelay(2000, 3000)
+	browser.HumanScroll(page)
+
+	items, err := s.sel.OnPage(page, "search.item").All()
+	if err != nil || len(items) == 0 {
+		log.Println("    ℹ️ No job cards found")
+		return 0, 0, nil
+	}
+	log.Printf("    📄 Found %d potential jobs.", len(items))
+
+	var wg sync.WaitGroup
+	results := make(chan scraper.Job, len(items))
+	seenCards := 0
+
+	//card links are read sequentially (Playwright page is NOT thread-safe),
+	//detail pages are fetched concurrently in their own tabs
+	for _, item := range items {
+		link := s.sel.In(item, "item.link").First()
+		href, _ := link.GetAttribute("href", playwright.LocatorGetAttributeOptions{Timeout: playwright.Float(1000)})
+		jobLink := jobURL(href)
+		if jobLink == "" {
+			continue
+		}
+		if pager.Seen(jobLink) {
+			seenCards++
+			continue
+		}
+		if seenURLs[jobLink] {
+			continue
+		}
+		seenURLs[jobLink] = true
+
+		//fast filter: no detail tab for senior titles
+		title, _ := link.InnerText(playwright.LocatorInnerTextOptions{Timeout: playwright.Float(1000)})
+		if title = strings.TrimSpace(title); title != "" && filter.IsExcludedLevel(title) {
+			log.Printf("    ❌ Skipped (Fast Title): %s", title)
+			continue
+		}
+
+		if !pager.TakeCard() {
+			log.Println("    🛑 LinkedIn card budget reached.")
+			break
+		}
+
+		wg.Add(1)
+		go func(jobLink string) {
+			defer wg.Done()
+			job, err := s.fetchJobDetail(ctx, browserCtx, jobLink)
+			if err != nil {
+				log.Printf("      ⚠️ Job Processing Error: %v", err)
+				return
+			}
+			results <- job
+		}(jobLink)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for job := range results {
+		if filter.AnalyzeLocation(job.Location + " " + job.Description).HanoiOnly() {
+			log.Println("      ❌ [Target Failed] Location Hanoi")
+			continue
+		}
+		log.Printf("      ✅ %s - %s - %s", job.Title, job.Location, job.PostedDate)
+		if err := scraper.Send(ctx, out, job); err != nil {
+			return len(items), seenCards, err
+		}
+	}
+	return len(items), seenCards, nil
+}
+
+// fetchJobDetail reads a job view page through the shared tab pool
+func (s *LinkedInScraper) fetchJobDetail(ctx context.Context, browserCtx playwright.BrowserContext, jobLink string) (scraper.Job, error) {
+	return s.details.Fetch(ctx, browserCtx, jobLink, func(page playwright.Page) (scraper.Job, error) {
+		return s.readJobDetail(page, jobLink, time.Now())
+	})
+}
+
+// readJobDetail extracts a job from a loaded job view page (fails fast when the top card is missing)
+func (s *LinkedInScraper) readJobDetail(page playwright.Page, jobLink string, now time.Time) (scraper.Job, error) {
+	if _, err := page.WaitForSelector(s.sel.CSS("detail.ready"), playwright.PageWaitForSelectorOptions{
+		Timeout: playwright.Float(5000),
+	}); err != nil {
+		if count, _ := page.Locator(s.sel.CSS("detail.auth_wall")).Count(); count > 0 {
+			return scraper.Job{}, fmt.Errorf("auth wall on %s", jobLink)
+		}
+		return scraper.Job{}, fmt.Errorf("job details not found on %s", jobLink)
+	}
+
+	title, _ := s.sel.OnPage(page, "detail.title").First().InnerText()
+	company, _ := s.sel.OnPage(page, "detail.company").First().InnerText()
+
+	location, postedDate := "Unknown Location", "Recent"
+	if primary := s.sel.OnPage(page, "detail.primary_description").First(); countOf(primary) > 0 {
+		text, _ := primary.InnerText()
+		location, postedDate = parseTopCard(text, now)
+	} else if text, err := s.sel.OnPage(page, "detail.location").First().InnerText(playwright.LocatorInnerTextOptions{
+		Timeout: playwright.Float(1000),
+	}); err == nil {
+		location = strings.TrimSpace(text)
+	}
+
+	//the button has pointer-events: none, so the click is forced
+	showMore := s.sel.OnPage(page, "detail.show_more")
+	if visible, _ := showMore.IsVisible(); visible {
+		showMore.Click(playwright.LocatorClickOptions{Force: playwright.Bool(true)})
+		page.WaitForTimeout(500)
+	}
+
+	description := ""
+	if descEl := s.sel.OnPage(page, "detail.description").First(); countOf(descEl) > 0 {
+		description, _ = descEl.InnerText()
+	}
+	description = truncate(strings.TrimSpace(description), 5000)
+
+	return scraper.Job{
+		Title:       strings.TrimSpace(title),
+		Company:     strings.TrimSpace(company),
+		URL:         jobLink,
+		Location:    finalLocation(location, description),
+		Salary:      "Negotiable",
+		Techstack:   "Golang",
+		Description: description,
+		Source:      "LinkedIn",
+		PostedDate:  postedDate,
+	}, nil
+}
+
+// searchPosts scans the newest posts of a content search and sends the recent hiring ones
+func (s *LinkedInScraper) searchPosts(ctx context.Context, page playwright.Page, keyword string, seenURLs map[string]bool, out chan<- scraper.Job) error {
+	postsURL := postSearchURL(keyword)
+	log.Printf("    📝 Visiting Post Search: %s", postsURL)
+	resp, err := browser.Goto(ctx, page, postsURL, playwright.PageGotoOptions{
+		WaitUntil: playwright.WaitUntilStateDomcontentloaded,
+		Timeout:   playwright.Float(30000),
+	})
+	if err != nil {
+		log.Printf("    ⚠️ Failed to load post search page: %v", err)
+		return nil
+	}
+	if err := s.challenge.Check(ctx, page, resp); err != nil {
+		return fmt.Errorf("linkedin: post search: %w", err)
+	}
+	browser.RandomDelay(2000, 3000)
+
+	if _, err := page.WaitForSelector(s.sel.CSS("posts.update"), playwright.PageWaitForSelectorOptions{
+		Timeout: playwright.Float(15000),
+	}); err != nil {
+		log.Println("    ⚠️ Posts not found (might be no results).")
+		return nil
+	}
+	browser.HumanScroll(page)
+
+	updates, err := s.sel.OnPage(page, "posts.update").All()
+	if err != nil {
+		log.Printf("    ⚠️ Error finding posts: %v", err)
+		return nil
+	}
+	log.Printf("    📄 Found %d potential posts.", len(updates))
+
+	found := 0
+	now := time.Now()
+	for i, update := range updates {
+		if i >= maxPostsScanned || found >= maxPostsPerKeyword {
+			break
+		}
+
+		p, ok := s.readPost(page, update)
+		if !ok || p.Age > maxPostAge {
+			continue
+		}
+		job, ok := postToJob(p, now)
+		if !ok || seenURLs[job.URL] {
+			continue
+		}
+		seenURLs[job.URL] = true
+		found++
+		log.Printf("      ✅ Valid Post! %s", truncate(job.Title, 60))
+		if err := scraper.Send(ctx, out, job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readPost reads a search result update; false when it has no age in its header (promoted posts)
+func (s *LinkedInScraper) readPost(page playwright.Page, update playwright.Locator) (post, bool) {
+	header, _ := s.sel.In(update, "post.sub_description").First().InnerText(playwright.LocatorInnerTextOptions{
+		Timeout: playwright.Float(1000),
+	})
+	age, ok := parsePostAge(header, time.Now())
+	if !ok {
+		return post{}, false
+	}
+
+	seeMore := s.sel.In(update, "post.see_more").First()
+	if visible, _ := seeMore.IsVisible(); visible {
+		seeMore.Click(playwright.LocatorClickOptions{Force: playwright.Bool(true)})
+		page.WaitForTimeout(500)
+	}
+
+	text, _ := s.sel.In(update, "post.content").First().InnerText(playwright.LocatorInnerTextOptions{
+		Timeout: playwright.Float(1000),
+	})
+	author, _ := s.sel.In(update, "post.author").First().InnerText(playwright.LocatorInnerTextOptions{
+		Timeout: playwright.Float(1000),
+	})
+	urn, _ := update.GetAttribute("data-urn", playwright.LocatorGetAttributeOptions{Timeout: playwright.Float(1000)})
+
+	return post{URN: urn, Author: author, Text: text, Age: age}, true
+}
+
+func countOf(loc playwright.Locator) int {
+	count, _ := loc.Count()
+	return count
+}
@@ -0,0 +1,93 @@
+package linkedin
+
+import (
+	"context"
+	"errors"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper/scrapertest"
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLinkedInScraper_ReadJobDetail_Replay parses a recorded job view page (no network)
+func TestLinkedInScraper_ReadJobDetail_Replay(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+	scrapertest.Attach(t, browserCtx, "testdata/replay", scrapertest.ModeFromEnv())
+
+	page, err := browserCtx.NewPage()
+	if err != nil {
+		t.Fatalf("could not create page: %v", err)
+	}
+	defer page.Close()
+
+	jobLink := "https://www.linkedin.com/jobs/view/4000000001/"
+	if _, err := page.Goto(jobLink); err != nil {
+		t.Fatalf("could not load job view: %v", err)
+	}
+
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	s := NewLinkedInScraper(&config.Config{Keywords: []string{"golang"}})
+	job, err := s.readJobDetail(page, jobLink, now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Junior Golang Engineer", job.Title)
+	assert.Equal(t, "Acme", job.Company)
+	assert.Equal(t, "HCM", job.Location)
+	assert.Equal(t, "2026-10-09", job.PostedDate)
+	assert.Equal(t, "LinkedIn", job.Source)
+	assert.Contains(t, job.Description, "junior Go engineer")
+}
+
+// TestLinkedInScraper_Scrape_Replay runs both modes on recorded pages (no network).
+// The search page has a job in HCM, a Hanoi-only job (dropped after its detail page),
+// a senior card (skipped without opening it) and a duplicate link with other tracking
+// parameters; the post search has one recent hiring post, an old one, a non-job post
+// and a promoted post without an age.
+// Re-record with: SCRAPERTEST_RECORD=1 go test -run TestLinkedInScraper_Scrape_Replay ./internal/scraper/linkedin/
+func TestLinkedInScraper_Scrape_Replay(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+	scrapertest.Attach(t, browserCtx, "testdata/replay", scrapertest.ModeFromEnv())
+
+	cfg := &config.Config{Keywords: []string{"golang"}}
+	jobs, err := NewLinkedInScraper(cfg).Scrape(context.Background(), browserCtx)
+
+	assert.NoError(t, err)
+	if !assert.Len(t, jobs, 2) {
+		return
+	}
+
+	assert.Equal(t, "Junior Golang Engineer", jobs[0].Title)
+	assert.Equal(t, "https://www.linkedin.com/jobs/view/4000000001/", jobs[0].URL)
+	assert.Equal(t, "LinkedIn", jobs[0].Source)
+
+	assert.Equal(t, "https://www.linkedin.com/feed/update/urn:li:activity:7300000000000000001/", jobs[1].URL)
+	assert.Equal(t, "Linh Tran", jobs[1].Company)
+	assert.Equal(t, "LinkedIn (Post)", jobs[1].Source)
+	assert.Equal(t, time.Now().Add(-3*time.Hour).Format("2006-01-02"), jobs[1].PostedDate)
+}
+
+// TestLinkedInScraper_Scrape_LoginFailed verifies that expired cookies stop the run with an error
+func TestLinkedInScraper_Scrape_LoginFailed(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+
+	mockHTML := `<html><title>LinkedIn Login, Sign in | LinkedIn</title><body><form class="login__form"></form></body></html>`
+	if err := browserCtx.Route("**/*", func(route playwright.Route) {
+		route.Fulfill(playwright.RouteFulfillOptions{
+			Status: playwright.Int(200),
+			Body:   mockHTML,
+		})
+	}); err != nil {
+		t.Fatalf("could not set up route interception: %v", err)
+	}
+
+	cfg := &config.Config{Keywords: []string{"golang"}}
+	cfg.Challenge = config.ChallengeRules{EvidenceDir: t.TempDir()}
+	jobs, err := NewLinkedInScraper(cfg).Scrape(context.Background(), browserCtx)
+
+	assert.True(t, errors.Is(err, browser.ErrLoginRequired), "got %v", err)
+	assert.Empty(t, jobs)
+}
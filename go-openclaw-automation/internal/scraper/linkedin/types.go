@@ -0,0 +1,243 @@
+package linkedin
+
+import (
+	"fmt"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/filter"
+	"go-openclaw-automation/internal/scraper"
+	"go-openclaw-automation/utils"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// resultsPerPage is LinkedIn's job search page size, used for the "start" offset
+const resultsPerPage = 25
+
+// Search filters of the Node scraper, used when config.yaml has no linkedin section
+var (
+	defaultExperienceLevels = []string{"internship", "entry", "associate"}
+	defaultWorkTypes        = []string{"onsite", "hybrid"}
+	defaultModes            = []string{"jobs", "posts"}
+)
+
+const (
+	defaultGeoID        = "104195383" //Vietnam
+	defaultPostedWithin = 30 * 24 * time.Hour
+)
+
+// experienceCodes and workTypeCodes map config names to the f_E and f_WT values
+var (
+	experienceCodes = map[string]string{
+		"internship": "1",
+		"entry":      "2",
+		"associate":  "3",
+		"mid_senior": "4",
+		"director":   "5",
+		"executive":  "6",
+	}
+	workTypeCodes = map[string]string{
+		"onsite": "1",
+		"remote": "2",
+		"hybrid": "3",
+	}
+)
+
+// searchOptions returns the configured job search filters with the scraper defaults filled in
+func searchOptions(cfg *config.Config) config.LinkedInSearch {
+	opts := cfg.LinkedIn
+	if len(opts.ExperienceLevels) == 0 {
+		opts.ExperienceLevels = defaultExperienceLevels
+	}
+	if len(opts.WorkTypes) == 0 {
+		opts.WorkTypes = defaultWorkTypes
+	}
+	if opts.GeoID == "" {
+		opts.GeoID = defaultGeoID
+	}
+	if opts.PostedWithin <= 0 {
+		opts.PostedWithin = defaultPostedWithin
+	}
+	if len(opts.Modes) == 0 {
+		opts.Modes = defaultModes
+	}
+	return opts
+}
+
+// hasMode reports whether mode ("jobs" or "posts") is enabled
+func hasMode(opts config.LinkedInSearch, mode string) bool {
+	for _, m := range opts.Modes {
+		if strings.EqualFold(strings.TrimSpace(m), mode) {
+			return true
+		}
+	}
+	return false
+}
+
+// searchURL builds a newest-first job search; page 1 has no "start" offset
+func searchURL(keyword string, opts config.LinkedInSearch, pageNum int) string {
+	q := url.Values{}
+	q.Set("keywords", keyword)
+	q.Set("geoId", opts.GeoID)
+	if codes := filterCodes(opts.ExperienceLevels, experienceCodes); codes != "" {
+		q.Set("f_E", codes)
+	}
+	if codes := filterCodes(opts.WorkTypes, workTypeCodes); codes != "" {
+		q.Set("f_WT", codes)
+	}
+	if seconds := int(opts.PostedWithin.Seconds()); seconds > 0 {
+		q.Set("f_TPR", fmt.Sprintf("r%d", seconds))
+	}
+	q.Set("sortBy", "DD")
+	if pageNum > 1 {
+		q.Set("start", strconv.Itoa((pageNum-1)*resultsPerPage))
+	}
+	return "https://www.linkedin.com/jobs/search/?" + q.Encode()
+}
+
+// postSearchURL builds a newest-first content search
+func postSearchURL(keyword string) string {
+	q := url.Values{}
+	q.Set("keywords", keyword)
+	q.Set("origin", "FACETED_SEARCH")
+	q.Set("sortBy", `"date_posted"`)
+	return "https://www.linkedin.com/search/results/content/?" + q.Encode()
+}
+
+// filterCodes joins the LinkedIn codes of the configured names (codes are accepted as is,
+// unknown names are dropped) into a comma-separated filter value
+func filterCodes(values []string, known map[string]string) string {
+	var codes []string
+	for _, v := range values {
+		v = strings.ToLower(strings.TrimSpace(v))
+		code, ok := known[strings.ReplaceAll(strings.ReplaceAll(v, "-", "_"), " ", "_")]
+		if !ok {
+			if _, err := strconv.Atoi(v); err != nil {
+				continue
+			}
+			code = v
+		}
+		if !contains(codes, code) {
+			codes = append(codes, code)
+		}
+	}
+	return strings.Join(codes, ",")
+}
+
+// jobURL makes a card link absolute and drops its tracking parameters (?refId=..., ?trackingId=...),
+// which would make the same job look like different URLs
+func jobURL(href string) string {
+	href = strings.TrimSpace(href)
+	if href == "" {
+		return ""
+	}
+	if !strings.HasPrefix(href, "http") {
+		href = "https://www.linkedin.com" + href
+	}
+	if i := strings.IndexAny(href, "?#"); i != -1 {
+		href = href[:i]
+	}
+	return href
+}
+
+// parseTopCard splits the detail page's primary description
+// ("Ho Chi Minh City, Vietnam · 1 week ago · 25 applicants") into the location and the posted date
+func parseTopCard(text string, now time.Time) (string, string) {
+	parts := strings.Split(text, "·")
+	location := strings.TrimSpace(parts[0])
+	for _, part := range parts[1:] {
+		if posted, confidence := utils.ParseDate(part, now); confidence != utils.DateUnknown {
+			return location, posted.Format("2006-01-02")
+		}
+	}
+	return location, "Recent"
+}
+
+// parsePostAge reads the age in a post header ("5h • Edited •", "3d •", "Vừa xong");
+// false when the header has none
+func parsePostAge(text string, now time.Time) (time.Duration, bool) {
+	posted, confidence := utils.ParseDate(text, now)
+	if confidence == utils.DateUnknown {
+		return 0, false
+	}
+	return now.Sub(posted), true
+}
+
+// post is a LinkedIn content search result
+type post struct {
+	URN    string //urn:li:activity:...
+	Author string
+	Text   string
+	Age    time.Duration
+}
+
+// postToJob keeps hiring posts that are not only about Hanoi
+func postToJob(p post, now time.Time) (scraper.Job, bool) {
+	text := strings.TrimSpace(p.Text)
+	if p.URN == "" || len([]rune(text)) < 50 {
+		return scraper.Job{}, false
+	}
+	if !filter.IsPotentialJobPost(text) {
+		return scraper.Job{}, false
+	}
+	location := filter.AnalyzeLocation(text)
+	if location.HanoiOnly() || filter.HasExplicitNonPreferredLocation(text) {
+		return scraper.Job{}, false
+	}
+
+	author := strings.TrimSpace(p.Author)
+	if author == "" {
+		author = "LinkedIn User"
+	}
+
+	title := fmt.Sprintf("[Post] %s is hiring", author)
+	firstLine, _, _ := strings.Cut(text, "\n")
+	if snippet := strings.TrimSpace(truncate(firstLine, 80)); snippet != "" {
+		title = "[Post] " + snippet + "..."
+	}
+
+	postLocation := location.Preferred
+	if postLocation == "Hanoi" {
+		postLocation = "Unknown"
+	}
+
+	return scraper.Job{
+		Title:       title,
+		Company:     author,
+		URL:         "https://www.linkedin.com/feed/update/" + p.URN + "/",
+		Location:    postLocation,
+		Salary:      "Negotiable",
+		Techstack:   "Golang",
+		Description: truncate(text, 5000),
+		Source:      "LinkedIn (Post)",
+		PostedDate:  now.Add(-p.Age).Format("2006-01-02"),
+	}, true
+}
+
+// finalLocation prefers the city we care about found in the location line or description,
+// the raw location line otherwise
+func finalLocation(location, description string) string {
+	info := filter.AnalyzeLocation(location + " " + description)
+	if info.Preferred == "Unknown" || info.Preferred == "Hanoi" {
+		return location
+	}
+	return info.Preferred
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func truncate(s string, max int) string {
+	r := []rune(s)
+	if len(r) <= max {
+		return s
+	}
+	return string(r[:max])
+}
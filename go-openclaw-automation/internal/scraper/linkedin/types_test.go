@@ -0,0 +1,116 @@
+package linkedin
+
+import (
+	"go-openclaw-automation/internal/config"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchURL(t *testing.T) {
+	opts := searchOptions(&config.Config{})
+
+	assert.Equal(t,
+		"https://www.linkedin.com/jobs/search/?f_E=1%2C2%2C3&f_TPR=r2592000&f_WT=1%2C3&geoId=104195383&keywords=golang+developer&sortBy=DD",
+		searchURL("golang developer", opts, 1))
+	assert.Contains(t, searchURL("golang", opts, 3), "&start=50")
+}
+
+func TestSearchURL_FromConfig(t *testing.T) {
+	opts := searchOptions(&config.Config{LinkedIn: config.LinkedInSearch{
+		ExperienceLevels: []string{"Entry", "mid-senior", "2", "unknown"},
+		WorkTypes:        []string{"remote"},
+		GeoID:            "90010187",
+		PostedWithin:     7 * 24 * time.Hour,
+		Modes:            []string{"posts"},
+	}})
+
+	u := searchURL("go", opts, 1)
+	assert.Contains(t, u, "f_E=2%2C4&")
+	assert.Contains(t, u, "f_WT=2&")
+	assert.Contains(t, u, "geoId=90010187")
+	assert.Contains(t, u, "f_TPR=r604800")
+	assert.False(t, hasMode(opts, "jobs"))
+	assert.True(t, hasMode(opts, "posts"))
+}
+
+func TestJobURL(t *testing.T) {
+	assert.Equal(t, "https://www.linkedin.com/jobs/view/4000000001/", jobURL("/jobs/view/4000000001/?refId=abc&trackingId=xyz"))
+	assert.Equal(t, "https://www.linkedin.com/jobs/view/4000000001/", jobURL("https://www.linkedin.com/jobs/view/4000000001/#apply"))
+	assert.Equal(t, "", jobURL("  "))
+}
+
+func TestParseTopCard(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		text     string
+		location string
+		posted   string
+	}{
+		{"Ho Chi Minh City, Vietnam · 6 days ago · 16 people clicked apply", "Ho Chi Minh City, Vietnam", "2026-10-10"},
+		{"Vietnam · Reposted 2 weeks ago · Over 100 applicants", "Vietnam", "2026-10-02"},
+		{"Thành phố Cần Thơ · 3 ngày trước · 12 người đã nhấp", "Thành phố Cần Thơ", "2026-10-13"},
+		{"Ho Chi Minh City, Vietnam · 25 applicants", "Ho Chi Minh City, Vietnam", "Recent"},
+	}
+	for _, tt := range tests {
+		location, posted := parseTopCard(tt.text, now)
+		assert.Equal(t, tt.location, location, tt.text)
+		assert.Equal(t, tt.posted, posted, tt.text)
+	}
+}
+
+func TestParsePostAge(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		header string
+		age    time.Duration
+		ok     bool
+	}{
+		{"3h • Edited •", 3 * time.Hour, true},
+		{"45m •", 45 * time.Minute, true},
+		{"2d •", 48 * time.Hour, true},
+		{"1w", 7 * 24 * time.Hour, true},
+		{"2mo •", 61 * 24 * time.Hour, true},
+		{"5 giờ •", 5 * time.Hour, true},
+		{"5 g • Đã chỉnh sửa", 5 * time.Hour, true},
+		{"Vừa xong", 0, true},
+		{"Promoted", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		age, ok := parsePostAge(tt.header, now)
+		assert.Equal(t, tt.ok, ok, tt.header)
+		assert.Equal(t, tt.age, age, tt.header)
+	}
+}
+
+func TestPostToJob(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	job, ok := postToJob(post{
+		URN:    "urn:li:activity:7300000000000000001",
+		Author: "Linh Tran",
+		Text:   "We are hiring a Junior Golang backend developer at our HCM office!\nStack: Go, PostgreSQL, Docker. Send your CV to jobs@gopher.vn",
+		Age:    30 * time.Hour,
+	}, now)
+	if assert.True(t, ok) {
+		assert.Equal(t, "[Post] We are hiring a Junior Golang backend developer at our HCM office!...", job.Title)
+		assert.Equal(t, "Linh Tran", job.Company)
+		assert.Equal(t, "https://www.linkedin.com/feed/update/urn:li:activity:7300000000000000001/", job.URL)
+		assert.Equal(t, "HCM", job.Location)
+		assert.Equal(t, "LinkedIn (Post)", job.Source)
+		assert.Equal(t, "2026-10-15", job.PostedDate)
+	}
+
+	_, ok = postToJob(post{URN: "urn:li:activity:2", Text: "We are hiring a Golang backend developer for our Hanoi office, send your CV today."}, now)
+	assert.False(t, ok, "Hanoi only")
+
+	_, ok = postToJob(post{URN: "urn:li:activity:3", Text: "Just wrote a blog post about Golang generics and how I used them in my side project."}, now)
+	assert.False(t, ok, "not a hiring post")
+
+	_, ok = postToJob(post{Text: "We are hiring a Golang backend developer at our HCM office, send your CV today."}, now)
+	assert.False(t, ok, "no permalink")
+}
@@ -0,0 +1,87 @@
+package scraper
+
+import "go-openclaw-automation/internal/config"
+
+// SeenFunc reports whether a job URL was already stored by a previous run
+type SeenFunc func(url string) bool
+
+// SeenAware is implemented by scrapers that can stop paginating on already-seen jobs.
+// The runner injects the DB lookup before scraping.
+type SeenAware interface {
+	SetSeen(seen SeenFunc)
+}
+
+// Paginator enforces a platform's page and card budget (max_pages / max_cards in config.yaml)
+// Not safe for concurrent use — a scraper paginates one search at a time.
+type Paginator struct {
+	maxPages int
+	maxCards int //0 = unlimited
+	cards    int
+	seen     SeenFunc
+}
+
+// defaultBudgets is the pagination budget of each platform when config.yaml does not set max_pages / max_cards
+var defaultBudgets = map[string]struct{ maxPages, maxCards int }{
+	"topcv":        {maxPages: 3, maxCards: 30},
+	"itviec":       {maxPages: 3, maxCards: 30},
+	"topdev":       {maxPages: 2, maxCards: 30},
+	"indeed":       {maxPages: 2, maxCards: 30},
+	"linkedin":     {maxPages: 1, maxCards: 40},
+	"vietnamworks": {maxPages: 2, maxCards: 100},
+}
+
+// PaginatorFor returns the paginator of a platform: max_pages / max_cards from config,
+// else the platform defaults (one page, no card limit for a platform without defaults)
+func PaginatorFor(cfg *config.Config, platform string, seen SeenFunc) *Paginator {
+	budget, ok := defaultBudgets[platform]
+	if !ok {
+		budget.maxPages = 1
+	}
+	return NewPaginator(cfg.Platform(platform), budget.maxPages, budget.maxCards, seen)
+}
+
+// NewPaginator reads the budget from the platform options, falling back to the scraper defaults
+func NewPaginator(opts config.PlatformConfig, defaultPages, defaultCards int, seen SeenFunc) *Paginator {
+	p := &Paginator{
+		maxPages: opts.MaxPages,
+		maxCards: opts.MaxCards,
+		seen:     seen,
+	}
+	if p.maxPages <= 0 {
+		p.maxPages = defaultPages
+	}
+	if p.maxCards <= 0 {
+		p.maxCards = defaultCards
+	}
+	return p
+}
+
+// HasPage reports whether page (1-based) of a search may be loaded
+func (p *Paginator) HasPage(page int) bool {
+	return page <= p.maxPages && !p.Exhausted()
+}
+
+// TakeCard reserves one card from the budget, false once it is used up
+func (p *Paginator) TakeCard() bool {
+	if p.Exhausted() {
+		return false
+	}
+	p.cards++
+	return true
+}
+
+// Exhausted reports whether the card budget is used up
+func (p *Paginator) Exhausted() bool {
+	return p.maxCards > 0 && p.cards >= p.maxCards
+}
+
+// Seen reports whether a job URL was stored by a previous run
+func (p *Paginator) Seen(url string) bool {
+	return p.seen != nil && url != "" && p.seen(url)
+}
+
+// Done decides whether to stop after a page with `cards` cards, `seenCards` of them already seen.
+// Results are sorted newest first, so a page of only seen jobs means the rest are older.
+func (p *Paginator) Done(cards, seenCards int) bool {
+	return cards == 0 || seenCards >= cards || p.Exhausted()
+}
@@ -0,0 +1,59 @@
+package scraper
+
+import (
+	"go-openclaw-automation/internal/config"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginator(t *testing.T) {
+	t.Run("defaults when config is empty", func(t *testing.T) {
+		p := NewPaginator(config.PlatformConfig{}, 2, 3, nil)
+		assert.True(t, p.HasPage(2))
+		assert.False(t, p.HasPage(3))
+
+		assert.True(t, p.TakeCard())
+		assert.True(t, p.TakeCard())
+		assert.True(t, p.TakeCard())
+		assert.False(t, p.TakeCard(), "card budget should be used up")
+		assert.False(t, p.HasPage(1), "no more pages once the card budget is used up")
+	})
+
+	t.Run("config overrides defaults", func(t *testing.T) {
+		p := NewPaginator(config.PlatformConfig{MaxPages: 5, MaxCards: 1}, 2, 3, nil)
+		assert.True(t, p.HasPage(5))
+		assert.True(t, p.TakeCard())
+		assert.False(t, p.TakeCard())
+	})
+
+	t.Run("stops on empty or fully seen pages", func(t *testing.T) {
+		seen := map[string]bool{"https://a": true}
+		p := NewPaginator(config.PlatformConfig{}, 3, 0, func(url string) bool { return seen[url] })
+
+		assert.True(t, p.Seen("https://a"))
+		assert.False(t, p.Seen("https://b"))
+		assert.True(t, p.Done(0, 0), "empty page")
+		assert.True(t, p.Done(4, 4), "only seen jobs")
+		assert.False(t, p.Done(4, 3), "some new jobs")
+	})
+
+	t.Run("nil seen func never reports seen", func(t *testing.T) {
+		p := NewPaginator(config.PlatformConfig{}, 1, 0, nil)
+		assert.False(t, p.Seen("https://a"))
+	})
+}
+
+func TestPaginatorFor(t *testing.T) {
+	p := PaginatorFor(&config.Config{}, "linkedin", nil)
+	assert.True(t, p.HasPage(1))
+	assert.False(t, p.HasPage(2), "linkedin defaults to one page")
+
+	cfg := &config.Config{EnabledPlatforms: map[string]config.PlatformConfig{"linkedin": {MaxPages: 3}}}
+	assert.True(t, PaginatorFor(cfg, "linkedin", nil).HasPage(3), "config overrides the platform default")
+
+	p = PaginatorFor(&config.Config{}, "unknown", nil)
+	assert.True(t, p.HasPage(1))
+	assert.False(t, p.HasPage(2))
+	assert.False(t, p.Exhausted(), "no card limit without defaults")
+}
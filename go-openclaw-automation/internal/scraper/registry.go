@@ -0,0 +1,96 @@
+// Registry of platform scrapers
+// Each platform package registers its factory in init(),
+// cmd/scraper builds the scraper set from config instead of a hardcoded list
+
+package scraper
+
+import (
+	"fmt"
+	"go-openclaw-automation/internal/config"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Factory builds a scraper for a platform from the loaded config
+type Factory func(cfg *config.Config) Scraper
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Factory)
+)
+
+// Register makes a platform available to Build under the given name.
+// It panics on duplicate names since that is always a programming error.
+func Register(name string, factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	name = strings.ToLower(name)
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("scraper: platform %q registered twice", name))
+	}
+	registry[name] = factory
+}
+
+// Registered returns the sorted names of all registered platforms
+func Registered() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Enabled returns the platforms that should run: those enabled in config, narrowed to
+// `selected` unless it is empty or contains "all" (mirrors the Node --platform argument).
+// If config has no enabled_platforms section, every registered platform is enabled.
+func Enabled(cfg *config.Config, selected []string) ([]string, error) {
+	registered := Registered()
+	known := make(map[string]bool, len(registered))
+	for _, name := range registered {
+		known[name] = true
+	}
+
+	//validate selection first so typos fail loudly
+	wanted := make(map[string]bool)
+	for _, name := range selected {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if name != "all" && !known[name] {
+			return nil, fmt.Errorf("unknown platform %q (registered: %s)", name, strings.Join(registered, ", "))
+		}
+		wanted[name] = true
+	}
+	runAll := len(wanted) == 0 || wanted["all"]
+
+	var names []string
+	for _, name := range registered {
+		if len(cfg.EnabledPlatforms) > 0 && !cfg.Platform(name).Enabled {
+			continue
+		}
+		if !runAll && !wanted[name] {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// New instantiates the registered scraper for a platform name
+func New(name string, cfg *config.Config) (Scraper, error) {
+	registryMu.RLock()
+	factory, ok := registry[strings.ToLower(name)]
+	registryMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown platform %q", name)
+	}
+	return factory(cfg), nil
+}
@@ -0,0 +1,190 @@
+// Salary parsing
+// Turns the free-text Job.Salary of every source ("10 - 20 triệu", "Up to $1,500", "Thỏa thuận",
+// "15000000 - 25000000 VND/month") into a range that can be filtered and sorted on
+
+package scraper
+
+import (
+	"go-openclaw-automation/utils"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Salary periods
+const (
+	PeriodHour  = "hour"
+	PeriodDay   = "day"
+	PeriodWeek  = "week"
+	PeriodMonth = "month"
+	PeriodYear  = "year"
+)
+
+// Salary is a parsed salary range. Min or Max is 0 when that side is open ("Up to $1,500").
+// Negotiable is set when the text says so or holds no amount at all.
+type Salary struct {
+	Min        int64
+	Max        int64
+	Currency   string //VND or USD, empty when the text does not tell
+	Period     string //PeriodMonth unless the text names another one
+	Negotiable bool
+}
+
+// Known reports whether the salary has an amount
+func (s Salary) Known() bool {
+	return s.Min > 0 || s.Max > 0
+}
+
+// usdCeiling is where a salary without a currency stops being USD and becomes VND
+const usdCeiling = 100_000
+
+// Monthly converts the range to VND per month (usdToVND is the exchange rate).
+// ParseSalary always sets the currency of a known amount; without one the amounts are taken as VND.
+func (s Salary) Monthly(usdToVND float64) (int64, int64) {
+	factor := 1.0
+	if s.Currency == "USD" {
+		factor = usdToVND
+	}
+	switch s.Period {
+	case PeriodHour:
+		factor *= 176 //22 days of 8 hours
+	case PeriodDay:
+		factor *= 22
+	case PeriodWeek:
+		factor *= 52.0 / 12
+	case PeriodYear:
+		factor /= 12
+	}
+	return int64(math.Round(float64(s.Min) * factor)), int64(math.Round(float64(s.Max) * factor))
+}
+
+var (
+	//"Thỏa thuận", "Negotiable", "Cạnh tranh", "Competitive", "Login to view salary"
+	negotiableRegex = regexp.MustCompile(`\b(thoa thuan|negotiable|negotiate|canh tranh|competitive|thuong luong|dang nhap|login|sign in)\b`)
+	//an amount with its thousands separators or decimal point and an optional multiplier
+	amountRegex = regexp.MustCompile(`(\d+(?:[.,]\d+)*)(?:\s*(trieu|tr|million|m|k|ty)\b)?`)
+	//"Up to", "Tới", "Lên đến", "Dưới" before a single amount: it is the maximum
+	upToRegex = regexp.MustCompile(`\b(up to|upto|toi da|toi|len den|len toi|den|duoi|under|max)\b`)
+	//"Từ", "Trên", "From", "15M+" with a single amount: it is the minimum
+	fromRegex = regexp.MustCompile(`\b(tu|tren|hon|from|over|above|at least|min)\b|\d\s*(trieu|tr|m|k)?\s*\+`)
+	usdRegex  = regexp.MustCompile(`\$|\busd\b`)
+	vndRegex  = regexp.MustCompile(`₫|\bvnd\b|\bd\b|\btrieu\b|\btr\b|\bty\b`)
+
+	//"/month", "per year", "mỗi giờ", "hourly"; a month unless another period is named
+	periodRegexes = []struct {
+		re     *regexp.Regexp
+		period string
+	}{
+		{regexp.MustCompile(`(/|\bper |\ba |\bmoi |\bmot )\s*(hour|hr|gio)\b|\bhourly\b`), PeriodHour},
+		{regexp.MustCompile(`(/|\bper |\ba |\bmoi |\bmot )\s*(day|ngay)\b|\bdaily\b`), PeriodDay},
+		{regexp.MustCompile(`(/|\bper |\ba |\bmoi |\bmot )\s*(week|tuan)\b|\bweekly\b`), PeriodWeek},
+		{regexp.MustCompile(`(/|\bper |\ba |\bmoi |\bmot )\s*(year|annum|nam)\b|\b(yearly|annual|annually)\b`), PeriodYear},
+	}
+)
+
+// ParseSalary reads a salary text in Vietnamese or English
+func ParseSalary(text string) Salary {
+	normalized := normalizeSalaryText(text)
+	salary := Salary{
+		Period:     PeriodMonth,
+		Negotiable: negotiableRegex.MatchString(normalized),
+	}
+
+	var amounts []int64
+	matches := amountRegex.FindAllStringSubmatch(normalized, -1)
+	for i, match := range matches {
+		multiplier := match[2]
+		//"10 - 20 triệu": the multiplier written once applies to both ends
+		if multiplier == "" && i == 0 && len(matches) > 1 {
+			multiplier = matches[1][2]
+		}
+		if amount := parseAmount(match[1], multiplier); amount > 0 {
+			amounts = append(amounts, amount)
+		}
+		if len(amounts) == 2 {
+			break
+		}
+	}
+	if len(amounts) == 0 {
+		salary.Negotiable = true
+		return salary
+	}
+
+	switch {
+	case len(amounts) == 2:
+		salary.Min, salary.Max = min(amounts[0], amounts[1]), max(amounts[0], amounts[1])
+	case upToRegex.MatchString(normalized):
+		salary.Max = amounts[0]
+	case fromRegex.MatchString(normalized):
+		salary.Min = amounts[0]
+	default:
+		salary.Min, salary.Max = amounts[0], amounts[0]
+	}
+
+	switch {
+	case usdRegex.MatchString(normalized):
+		salary.Currency = "USD"
+	case vndRegex.MatchString(normalized):
+		salary.Currency = "VND"
+	case max(salary.Min, salary.Max) >= usdCeiling:
+		//no currency: millions are VND
+		salary.Currency = "VND"
+	default:
+		//no currency: nobody pays "1,500" VND, IT listings quoting small amounts mean USD
+		salary.Currency = "USD"
+	}
+
+	for _, p := range periodRegexes {
+		if p.re.MatchString(normalized) {
+			salary.Period = p.period
+			break
+		}
+	}
+	return salary
+}
+
+// parseAmount reads "1,500", "15.000.000", "1.5" with an optional multiplier ("triệu", "k")
+func parseAmount(number, multiplier string) int64 {
+	value, err := strconv.ParseFloat(numberDigits(number), 64)
+	if err != nil {
+		return 0
+	}
+	switch multiplier {
+	case "trieu", "tr", "million", "m":
+		value *= 1_000_000
+	case "ty":
+		value *= 1_000_000_000
+	case "k":
+		value *= 1_000
+	}
+	return int64(math.Round(value))
+}
+
+// numberDigits drops thousands separators: every group after a separator has 3 digits
+// ("15.000.000", "1,500"); otherwise a single separator is the decimal point ("1.5", "12,5")
+func numberDigits(number string) string {
+	groups := strings.FieldsFunc(number, func(r rune) bool { return r == '.' || r == ',' })
+	if len(groups) == 1 {
+		return number
+	}
+	thousands := true
+	for _, group := range groups[1:] {
+		if len(group) != 3 {
+			thousands = false
+			break
+		}
+	}
+	if thousands {
+		return strings.Join(groups, "")
+	}
+	if len(groups) == 2 {
+		return groups[0] + "." + groups[1]
+	}
+	return strings.Join(groups, "")
+}
+
+// normalizeSalaryText lowercases and strips Vietnamese diacritics ("Thỏa thuận" -> "thoa thuan")
+func normalizeSalaryText(text string) string {
+	return strings.Join(strings.Fields(utils.NormalizeText(text)), " ")
+}
@@ -0,0 +1,57 @@
+package scraper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSalary(t *testing.T) {
+	tests := []struct {
+		text string
+		want Salary
+	}{
+		{"10 - 20 triệu", Salary{Min: 10_000_000, Max: 20_000_000, Currency: "VND", Period: PeriodMonth}},
+		{"Tới 25 triệu", Salary{Max: 25_000_000, Currency: "VND", Period: PeriodMonth}},
+		{"Từ 15 triệu", Salary{Min: 15_000_000, Currency: "VND", Period: PeriodMonth}},
+		{"1.5 - 2 triệu", Salary{Min: 1_500_000, Max: 2_000_000, Currency: "VND", Period: PeriodMonth}},
+		{"15tr - 20tr", Salary{Min: 15_000_000, Max: 20_000_000, Currency: "VND", Period: PeriodMonth}},
+		{"12.000.000 - 18.000.000 đ", Salary{Min: 12_000_000, Max: 18_000_000, Currency: "VND", Period: PeriodMonth}},
+		{"20M+", Salary{Min: 20_000_000, Currency: "VND", Period: PeriodMonth}},
+		{"15000000 - 25000000 VND/month", Salary{Min: 15_000_000, Max: 25_000_000, Currency: "VND", Period: PeriodMonth}},
+		{"Up to $1,500", Salary{Max: 1_500, Currency: "USD", Period: PeriodMonth}},
+		{"1,000 - 1,500 USD", Salary{Min: 1_000, Max: 1_500, Currency: "USD", Period: PeriodMonth}},
+		{"Trên 1000 USD", Salary{Min: 1_000, Currency: "USD", Period: PeriodMonth}},
+		{"$1.5k - $2k", Salary{Min: 1_500, Max: 2_000, Currency: "USD", Period: PeriodMonth}},
+		{"800 USD", Salary{Min: 800, Max: 800, Currency: "USD", Period: PeriodMonth}},
+		{"50000 - 70000 USD/year", Salary{Min: 50_000, Max: 70_000, Currency: "USD", Period: PeriodYear}},
+		{"$20 - $30 per hour", Salary{Min: 20, Max: 30, Currency: "USD", Period: PeriodHour}},
+		{"Lương 12 triệu, làm việc tại Việt Nam", Salary{Min: 12_000_000, Max: 12_000_000, Currency: "VND", Period: PeriodMonth}},
+		{"Up to 1,500", Salary{Max: 1_500, Currency: "USD", Period: PeriodMonth}},
+		{"800-1200", Salary{Min: 800, Max: 1_200, Currency: "USD", Period: PeriodMonth}},
+		{"15000000 - 20000000", Salary{Min: 15_000_000, Max: 20_000_000, Currency: "VND", Period: PeriodMonth}},
+		{"Thỏa thuận", Salary{Period: PeriodMonth, Negotiable: true}},
+		{"Negotiable", Salary{Period: PeriodMonth, Negotiable: true}},
+		{"Cạnh tranh", Salary{Period: PeriodMonth, Negotiable: true}},
+		{"Sign in to view salary", Salary{Period: PeriodMonth, Negotiable: true}},
+		{"", Salary{Period: PeriodMonth, Negotiable: true}},
+		{"Thỏa thuận (10 - 15 triệu)", Salary{Min: 10_000_000, Max: 15_000_000, Currency: "VND", Period: PeriodMonth, Negotiable: true}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ParseSalary(tt.text), tt.text)
+	}
+}
+
+func TestSalary_Monthly(t *testing.T) {
+	low, high := ParseSalary("1,000 - 1,500 USD").Monthly(25_000)
+	assert.EqualValues(t, 25_000_000, low)
+	assert.EqualValues(t, 37_500_000, high)
+
+	low, high = ParseSalary("240000000 VND/year").Monthly(25_000)
+	assert.EqualValues(t, 20_000_000, low)
+	assert.EqualValues(t, 20_000_000, high)
+
+	low, high = ParseSalary("Up to 20 triệu").Monthly(25_000)
+	assert.EqualValues(t, 0, low)
+	assert.EqualValues(t, 20_000_000, high)
+}
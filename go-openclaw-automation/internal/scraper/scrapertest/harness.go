@@ -0,0 +1,321 @@
+// Record/replay fixtures for Playwright scrapers
+// Record mode saves real HTML/JSON responses into a fixture directory,
+// replay mode serves them back through BrowserContext.Route with no network access
+
+package scrapertest
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// RecordEnv switches every harness to record mode: SCRAPERTEST_RECORD=1 go test ./internal/scraper/topcv/
+const RecordEnv = "SCRAPERTEST_RECORD"
+
+// indexFile lists the fixtures of a directory, it can also be written by hand
+const indexFile = "fixtures.json"
+
+type Mode int
+
+const (
+	// Replay serves fixtures and blocks every other request
+	Replay Mode = iota
+	// Record lets requests hit the network and saves HTML/JSON responses
+	Record
+)
+
+// ModeFromEnv returns Record when RecordEnv is set, Replay otherwise
+func ModeFromEnv() Mode {
+	if os.Getenv(RecordEnv) != "" {
+		return Record
+	}
+	return Replay
+}
+
+// Fixture is one recorded response.
+// URL may contain "*" wildcards (matching any characters, including "/") for hand-written fixtures.
+type Fixture struct {
+	Method      string `json:"method,omitempty"`
+	URL         string `json:"url"`
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type"`
+	BodyFile    string `json:"body_file"`
+}
+
+// Harness attaches fixtures to a BrowserContext
+type Harness struct {
+	t    testing.TB
+	dir  string
+	mode Mode
+
+	mu       sync.Mutex
+	fixtures []Fixture
+}
+
+// NewBrowserContext launches headless Chromium and returns a fresh BrowserContext.
+// Everything is closed on test cleanup. The test is skipped when the Playwright
+// driver is not installed, so offline unit runs stay green.
+func NewBrowserContext(t testing.TB) playwright.BrowserContext {
+	t.Helper()
+
+	pw, err := playwright.Run()
+	if err != nil {
+		t.Skipf("playwright not available: %v", err)
+	}
+
+	browser, err := pw.Chromium.Launch(playwright.BrowserTypeLaunchOptions{
+		Headless: playwright.Bool(true), // headless in tests to avoid opening windows
+	})
+	if err != nil {
+		pw.Stop()
+		t.Skipf("could not launch chromium: %v", err)
+	}
+
+	browserCtx, err := browser.NewContext()
+	if err != nil {
+		browser.Close()
+		pw.Stop()
+		t.Fatalf("could not create browser context: %v", err)
+	}
+
+	t.Cleanup(func() {
+		browserCtx.Close()
+		browser.Close()
+		pw.Stop()
+	})
+	return browserCtx
+}
+
+// Attach routes every request of browserCtx through the fixtures in dir.
+// In record mode the recorded responses are merged into the index on test cleanup.
+func Attach(t testing.TB, browserCtx playwright.BrowserContext, dir string, mode Mode) *Harness {
+	t.Helper()
+
+	h := &Harness{t: t, dir: dir, mode: mode}
+	if mode == Replay {
+		fixtures, err := LoadIndex(dir)
+		if err != nil {
+			t.Fatalf("scrapertest: %v", err)
+		}
+		h.fixtures = fixtures
+	} else {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("scrapertest: could not create fixture dir: %v", err)
+		}
+		t.Cleanup(func() {
+			if err := h.writeIndex(); err != nil {
+				t.Errorf("scrapertest: %v", err)
+			}
+		})
+	}
+
+	if err := browserCtx.Route("**/*", h.handle); err != nil {
+		t.Fatalf("scrapertest: could not set up route interception: %v", err)
+	}
+	return h
+}
+
+// LoadIndex reads the fixture index of dir
+func LoadIndex(dir string) ([]Fixture, error) {
+	data, err := os.ReadFile(filepath.Join(dir, indexFile))
+	if err != nil {
+		return nil, fmt.Errorf("could not read fixture index: %w", err)
+	}
+
+	var fixtures []Fixture
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		return nil, fmt.Errorf("could not parse fixture index: %w", err)
+	}
+	return fixtures, nil
+}
+
+// Match returns the fixture for a request: exact URLs win over wildcards, then first in file order
+func Match(fixtures []Fixture, method, url string) (Fixture, bool) {
+	var wildcard *Fixture
+	for i, f := range fixtures {
+		if f.Method != "" && !strings.EqualFold(f.Method, method) {
+			continue
+		}
+		if f.URL == url {
+			return f, true
+		}
+		if wildcard == nil && strings.Contains(f.URL, "*") && globMatch(f.URL, url) {
+			wildcard = &fixtures[i]
+		}
+	}
+	if wildcard != nil {
+		return *wildcard, true
+	}
+	return Fixture{}, false
+}
+
+// globMatch matches s against a pattern where "*" is any run of characters
+func globMatch(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	for i, part := range parts[1:] {
+		if i == len(parts)-2 {
+			return strings.HasSuffix(s, part)
+		}
+		idx := strings.Index(s, part)
+		if idx < 0 {
+			return false
+		}
+		s = s[idx+len(part):]
+	}
+	return s == ""
+}
+
+func (h *Harness) handle(route playwright.Route) {
+	if h.mode == Record {
+		h.record(route)
+		return
+	}
+	h.replay(route)
+}
+
+func (h *Harness) replay(route playwright.Route) {
+	req := route.Request()
+	fixture, ok := Match(h.fixtures, req.Method(), req.URL())
+	if !ok {
+		//no network in replay: unknown documents get an empty 404, assets are dropped
+		if isRecordable(req.ResourceType()) {
+			route.Fulfill(playwright.RouteFulfillOptions{Status: playwright.Int(404), Body: ""})
+			return
+		}
+		route.Abort()
+		return
+	}
+
+	body, err := os.ReadFile(filepath.Join(h.dir, fixture.BodyFile))
+	if err != nil {
+		h.t.Errorf("scrapertest: fixture %s: %v", fixture.URL, err)
+		route.Abort()
+		return
+	}
+
+	status := fixture.Status
+	if status == 0 {
+		status = 200
+	}
+	route.Fulfill(playwright.RouteFulfillOptions{
+		Status:      playwright.Int(status),
+		ContentType: playwright.String(fixture.ContentType),
+		Body:        body,
+	})
+}
+
+func (h *Harness) record(route playwright.Route) {
+	req := route.Request()
+	if !isRecordable(req.ResourceType()) {
+		route.Continue()
+		return
+	}
+
+	resp, err := route.Fetch()
+	if err != nil {
+		route.Abort()
+		return
+	}
+
+	contentType := resp.Headers()["content-type"]
+	if !strings.Contains(contentType, "html") && !strings.Contains(contentType, "json") {
+		route.Fulfill(playwright.RouteFulfillOptions{Response: resp})
+		return
+	}
+
+	body, err := resp.Body()
+	if err != nil {
+		route.Fulfill(playwright.RouteFulfillOptions{Response: resp})
+		return
+	}
+
+	name := fixtureName(req.Method(), req.URL(), contentType)
+	if err := os.WriteFile(filepath.Join(h.dir, name), body, 0644); err != nil {
+		h.t.Errorf("scrapertest: could not save fixture: %v", err)
+	} else {
+		h.mu.Lock()
+		h.fixtures = append(h.fixtures, Fixture{
+			Method:      req.Method(),
+			URL:         req.URL(),
+			Status:      resp.Status(),
+			ContentType: contentType,
+			BodyFile:    name,
+		})
+		h.mu.Unlock()
+	}
+
+	route.Fulfill(playwright.RouteFulfillOptions{Response: resp})
+}
+
+// writeIndex merges the recorded fixtures into the index on disk, which is re-read
+// here so hand-written entries and other harnesses on the same dir are kept
+func (h *Harness) writeIndex() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	existing, err := LoadIndex(h.dir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	data, err := json.MarshalIndent(mergeFixtures(existing, h.fixtures), "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal fixture index: %w", err)
+	}
+	return os.WriteFile(filepath.Join(h.dir, indexFile), data, 0644)
+}
+
+// mergeFixtures replaces existing entries recorded again (same method and URL)
+// and appends the new ones, wildcards and other entries keep their order
+func mergeFixtures(existing, recorded []Fixture) []Fixture {
+	merged := append([]Fixture(nil), existing...)
+	index := make(map[string]int, len(merged))
+	for i, f := range merged {
+		index[strings.ToUpper(f.Method)+" "+f.URL] = i
+	}
+
+	for _, f := range recorded {
+		key := strings.ToUpper(f.Method) + " " + f.URL
+		if i, ok := index[key]; ok {
+			merged[i] = f
+			continue
+		}
+		index[key] = len(merged)
+		merged = append(merged, f)
+	}
+	return merged
+}
+
+// isRecordable keeps documents and API calls, assets are never recorded
+func isRecordable(resourceType string) bool {
+	switch resourceType {
+	case "document", "xhr", "fetch":
+		return true
+	}
+	return false
+}
+
+// fixtureName derives a stable file name from the request
+func fixtureName(method, url, contentType string) string {
+	sum := sha1.Sum([]byte(method + " " + url))
+	ext := ".html"
+	if strings.Contains(contentType, "json") {
+		ext = ".json"
+	}
+	return hex.EncodeToString(sum[:8]) + ext
+}
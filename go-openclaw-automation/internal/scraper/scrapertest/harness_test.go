@@ -0,0 +1,75 @@
+package scrapertest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	fixtures := []Fixture{
+		{URL: "https://www.topcv.vn/tim-viec-lam-*", BodyFile: "search.html"},
+		{URL: "https://www.topcv.vn/tim-viec-lam-golang?exp=1", BodyFile: "exact.html"},
+		{Method: "POST", URL: "https://api.example.com/jobs", BodyFile: "post.json"},
+		{URL: "https://cdn.example.com/*/job-*.json", BodyFile: "nested.json"},
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		url      string
+		expected string
+	}{
+		{"exact wins over earlier wildcard", "GET", "https://www.topcv.vn/tim-viec-lam-golang?exp=1", "exact.html"},
+		{"wildcard", "GET", "https://www.topcv.vn/tim-viec-lam-golang?exp=2", "search.html"},
+		{"method must match", "POST", "https://api.example.com/jobs", "post.json"},
+		{"wildcard spans slashes", "GET", "https://cdn.example.com/a/b/job-42.json", "nested.json"},
+		{"no match", "GET", "https://api.example.com/jobs", ""},
+		{"wildcard suffix must match", "GET", "https://cdn.example.com/a/job-42.html", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture, ok := Match(fixtures, tt.method, tt.url)
+			assert.Equal(t, tt.expected != "", ok)
+			assert.Equal(t, tt.expected, fixture.BodyFile)
+		})
+	}
+}
+
+func TestMergeFixtures(t *testing.T) {
+	existing := []Fixture{
+		{URL: "https://www.topcv.vn/tim-viec-lam-*", BodyFile: "search.html"},
+		{Method: "GET", URL: "https://www.topcv.vn/viec-lam/1", BodyFile: "old.html"},
+	}
+	recorded := []Fixture{
+		{Method: "GET", URL: "https://www.topcv.vn/viec-lam/1", BodyFile: "new.html"},
+		{Method: "GET", URL: "https://www.topcv.vn/viec-lam/2", BodyFile: "second.html"},
+		{Method: "GET", URL: "https://www.topcv.vn/viec-lam/2", BodyFile: "second-again.html"},
+	}
+
+	merged := mergeFixtures(existing, recorded)
+	assert.Equal(t, []Fixture{
+		{URL: "https://www.topcv.vn/tim-viec-lam-*", BodyFile: "search.html"},
+		{Method: "GET", URL: "https://www.topcv.vn/viec-lam/1", BodyFile: "new.html"},
+		{Method: "GET", URL: "https://www.topcv.vn/viec-lam/2", BodyFile: "second-again.html"},
+	}, merged)
+	assert.Len(t, existing, 2, "existing index is not modified")
+	assert.Equal(t, "old.html", existing[1].BodyFile)
+}
+
+func TestWriteIndex_KeepsExistingEntries(t *testing.T) {
+	dir := t.TempDir()
+	first := &Harness{t: t, dir: dir, mode: Record, fixtures: []Fixture{{Method: "GET", URL: "https://a.example.com/", BodyFile: "a.html"}}}
+	second := &Harness{t: t, dir: dir, mode: Record, fixtures: []Fixture{{Method: "GET", URL: "https://b.example.com/", BodyFile: "b.html"}}}
+
+	assert.NoError(t, first.writeIndex())
+	assert.NoError(t, second.writeIndex())
+
+	fixtures, err := LoadIndex(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []Fixture{
+		{Method: "GET", URL: "https://a.example.com/", BodyFile: "a.html"},
+		{Method: "GET", URL: "https://b.example.com/", BodyFile: "b.html"},
+	}, fixtures)
+}
@@ -0,0 +1,163 @@
+// Versioned CSS selector packs per platform
+// Defaults are embedded from packs/*.yaml, a newer pack dropped into
+// cfg.SelectorsPath overrides them without a code change or redeploy
+
+package selectors
+
+import (
+	"embed"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/playwright-community/playwright-go"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed packs/*.yaml
+var embedded embed.FS
+
+// Pack is the selector set of one platform.
+// Every name maps to a fallback chain: the first selector that matches wins.
+type Pack struct {
+	Platform  string              `yaml:"platform"`
+	Version   string              `yaml:"version"`
+	Selectors map[string][]string `yaml:"selectors"`
+}
+
+// ForPlatform returns the pack for a platform. A pack in dir overrides the embedded one
+// name by name; a missing or broken override is logged and the embedded pack is used.
+func ForPlatform(dir, platform string) *Pack {
+	pack, err := Embedded(platform)
+	if err != nil {
+		//embedded packs ship with the binary, so this is a programming error
+		panic(err)
+	}
+
+	if dir == "" {
+		return pack
+	}
+	override, err := LoadFile(filepath.Join(dir, platform+".yaml"))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("⚠️ Could not load %s selector override, using embedded v%s: %v", platform, pack.Version, err)
+		}
+		return pack
+	}
+	return pack.Merge(override)
+}
+
+// Embedded returns the pack compiled into the binary
+func Embedded(platform string) (*Pack, error) {
+	data, err := embedded.ReadFile("packs/" + platform + ".yaml")
+	if err != nil {
+		return nil, fmt.Errorf("no embedded selector pack for %q: %w", platform, err)
+	}
+	return Parse(data)
+}
+
+// LoadFile reads a pack from disk
+func LoadFile(path string) (*Pack, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Parse decodes and validates a YAML pack
+func Parse(data []byte) (*Pack, error) {
+	pack := &Pack{}
+	if err := yaml.Unmarshal(data, pack); err != nil {
+		return nil, fmt.Errorf("invalid selector pack: %w", err)
+	}
+	if pack.Platform == "" || pack.Version == "" {
+		return nil, fmt.Errorf("selector pack needs platform and version")
+	}
+	for name, chain := range pack.Selectors {
+		if len(chain) == 0 {
+			return nil, fmt.Errorf("selector %q has an empty fallback chain", name)
+		}
+	}
+	return pack, nil
+}
+
+// Merge returns a copy of p where every name present in override replaces p's chain
+func (p *Pack) Merge(override *Pack) *Pack {
+	merged := &Pack{
+		Platform:  p.Platform,
+		Version:   override.Version,
+		Selectors: make(map[string][]string, len(p.Selectors)),
+	}
+	for name, chain := range p.Selectors {
+		merged.Selectors[name] = chain
+	}
+	for name, chain := range override.Selectors {
+		merged.Selectors[name] = chain
+	}
+	return merged
+}
+
+// Names returns the sorted selector names of the pack
+func (p *Pack) Names() []string {
+	names := make([]string, 0, len(p.Selectors))
+	for name := range p.Selectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Chain returns the fallback chain of a name. Scrapers only ask for names their
+// embedded pack defines, so an unknown name is a programming error.
+func (p *Pack) Chain(name string) []string {
+	chain, ok := p.Selectors[name]
+	if !ok {
+		panic(fmt.Sprintf("selectors: %s pack has no %q", p.Platform, name))
+	}
+	return chain
+}
+
+// CSS joins the chain into one selector list, for waits where any match will do
+func (p *Pack) CSS(name string) string {
+	return strings.Join(p.Chain(name), ", ")
+}
+
+// OnPage resolves a chain against the whole page
+func (p *Pack) OnPage(page playwright.Page, name string) playwright.Locator {
+	return p.resolve(name, func(sel string) playwright.Locator { return page.Locator(sel) })
+}
+
+// In resolves a chain inside a locator (e.g. one job card)
+func (p *Pack) In(root playwright.Locator, name string) playwright.Locator {
+	return p.resolve(name, func(sel string) playwright.Locator { return root.Locator(sel) })
+}
+
+// Find resolves a chain inside a parsed HTML document or selection (browserless scrapers)
+func (p *Pack) Find(root *goquery.Selection, name string) *goquery.Selection {
+	chain := p.Chain(name)
+	for _, sel := range chain {
+		if found := root.Find(sel); found.Length() > 0 {
+			return found
+		}
+	}
+	return root.Find(chain[0])
+}
+
+// resolve returns the locator of the first selector with a match.
+// When nothing matches, the primary selector's (empty) locator is returned
+// so callers keep their usual "not found" handling.
+func (p *Pack) resolve(name string, locate func(string) playwright.Locator) playwright.Locator {
+	chain := p.Chain(name)
+	for _, sel := range chain {
+		loc := locate(sel)
+		if count, _ := loc.Count(); count > 0 {
+			return loc
+		}
+	}
+	return locate(chain[0])
+}
@@ -0,0 +1,75 @@
+package selectors
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedPacks(t *testing.T) {
+	for _, platform := range []string{"topcv", "itviec", "linkedin"} {
+		t.Run(platform, func(t *testing.T) {
+			pack, err := Embedded(platform)
+			require.NoError(t, err)
+			assert.Equal(t, platform, pack.Platform)
+			assert.NotEmpty(t, pack.Version)
+			assert.NotEmpty(t, pack.Selectors)
+		})
+	}
+}
+
+func TestForPlatform_Override(t *testing.T) {
+	dir := t.TempDir()
+	override := `
+platform: topcv
+version: 2099.1.1
+selectors:
+  search.card:
+    - .new-card
+    - .job-item-search-result
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "topcv.yaml"), []byte(override), 0644))
+
+	pack := ForPlatform(dir, "topcv")
+	assert.Equal(t, "2099.1.1", pack.Version)
+	assert.Equal(t, []string{".new-card", ".job-item-search-result"}, pack.Chain("search.card"))
+	assert.Equal(t, "h3.title a, .title-block a, a.title", pack.CSS("card.title_link"), "names not overridden keep the embedded chain")
+}
+
+func TestForPlatform_BrokenOverrideFallsBack(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "itviec.yaml"), []byte("platform: itviec\nselectors: {}\n"), 0644))
+
+	embedded, err := Embedded("itviec")
+	require.NoError(t, err)
+
+	pack := ForPlatform(dir, "itviec")
+	assert.Equal(t, embedded.Version, pack.Version, "override without version is rejected")
+}
+
+func TestParse_EmptyChain(t *testing.T) {
+	_, err := Parse([]byte("platform: x\nversion: '1'\nselectors:\n  a: []\n"))
+	assert.Error(t, err)
+}
+
+func TestFind_FallbackChain(t *testing.T) {
+	pack, err := Parse([]byte(`
+platform: test
+version: "1"
+selectors:
+  card.title:
+    - h3.new-title
+    - h3.title
+`))
+	require.NoError(t, err)
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<div><h3 class="title">Golang Dev</h3></div>`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Golang Dev", pack.Find(doc.Selection, "card.title").Text())
+}
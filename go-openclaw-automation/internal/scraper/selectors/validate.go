@@ -0,0 +1,66 @@
+package selectors
+
+import (
+	"strings"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// Status of one selector name against a saved page
+type Status string
+
+const (
+	StatusOK       Status = "OK"       // primary selector matches
+	StatusFallback Status = "FALLBACK" // only a fallback matches — update the pack soon
+	StatusMissing  Status = "MISSING"  // nothing in the chain matches
+)
+
+// Result reports how each selector of a chain matched
+type Result struct {
+	Name    string
+	Status  Status
+	Counts  []int // match count per selector, same order as the chain
+	Matched string
+}
+
+// Validate checks every selector of the pack (optionally only names starting with one of
+// the prefixes, e.g. "search." or "card.") against a page with the saved HTML loaded
+func Validate(page playwright.Page, pack *Pack, prefixes ...string) ([]Result, error) {
+	var results []Result
+	for _, name := range pack.Names() {
+		if !hasAnyPrefix(name, prefixes) {
+			continue
+		}
+
+		chain := pack.Chain(name)
+		result := Result{Name: name, Status: StatusMissing, Counts: make([]int, len(chain))}
+		for i, sel := range chain {
+			count, err := page.Locator(sel).Count()
+			if err != nil {
+				return nil, err
+			}
+			result.Counts[i] = count
+			if count > 0 && result.Matched == "" {
+				result.Matched = sel
+				result.Status = StatusFallback
+				if i == 0 {
+					result.Status = StatusOK
+				}
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func hasAnyPrefix(name string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,330 @@
+package threads
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-openclaw-automation/internal/filter"
+	"go-openclaw-automation/internal/scraper"
+	"go-openclaw-automation/utils"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxJSONDepth stops the post search in deeply nested GraphQL payloads
+const maxJSONDepth = 15
+
+// maxPostAge is the Node scraper's dynamic date filter (2 months)
+const maxPostAge = 60 * 24 * time.Hour
+
+var (
+	//strict Golang relevance: Threads search also matches "go" in any sentence
+	relevantRegex = regexp.MustCompile(`(?i)\b(golang|go\s?lang|go\s?dev|go\s?engineer|backend\s?go)\b`)
+	fresherRegex  = regexp.MustCompile(`(?i)(fresher|junior|intern|thuc tap|moi ra truong)`)
+
+	negotiableRegex = regexp.MustCompile(`(?i)\b(negotiable|thoa thuan|thu nhap hap dan|luong hap dan|competitive|open salary)\b`)
+	moneyRegexes    = []*regexp.Regexp{
+		regexp.MustCompile(`((?:USD|usd|\$)\s?\d{1,3}(?:[.,]\d{3})*(?:\s?-\s?(?:USD|usd|\$)?\s?\d{1,3}(?:[.,]\d{3})*)?)`),
+		//"25-35tr" (unit on the upper bound only), "15tr - 20tr", "30 triệu"
+		regexp.MustCompile(`(?i)(\d{1,3}(?:[.,]\d{1,3})?\s?(?:(?:tr|triệu|trieu|m)\s?)?-\s?\d{1,3}(?:[.,]\d{1,3})?\s?(?:tr|triệu|trieu|m)|\d{1,3}(?:[.,]\d{1,3})?\s?(?:tr|triệu|trieu|m))`),
+		regexp.MustCompile(`(?i)(\d{1,3}(?:[.,]\d{3})+\s?(?:vnd|vnđ|đ))`),
+	}
+
+	techSignals = []struct {
+		label string
+		regex *regexp.Regexp
+	}{
+		{"Go/Golang", relevantRegex},
+		{"Docker", regexp.MustCompile(`(?i)\bdocker\b`)},
+		{"Kubernetes", regexp.MustCompile(`(?i)\bkubernetes|k8s\b`)},
+		{"AWS", regexp.MustCompile(`(?i)\baws\b`)},
+		{"GCP", regexp.MustCompile(`(?i)\bgcp|google cloud\b`)},
+		{"Azure", regexp.MustCompile(`(?i)\bazure\b`)},
+		{"PostgreSQL", regexp.MustCompile(`(?i)\bpostgres(?:ql)?\b`)},
+		{"MySQL", regexp.MustCompile(`(?i)\bmysql\b`)},
+		{"MongoDB", regexp.MustCompile(`(?i)\bmongodb|mongo\b`)},
+		{"Redis", regexp.MustCompile(`(?i)\bredis\b`)},
+		{"Kafka", regexp.MustCompile(`(?i)\bkafka\b`)},
+		{"RabbitMQ", regexp.MustCompile(`(?i)\brabbitmq\b`)},
+		{"gRPC", regexp.MustCompile(`(?i)\bgrpc\b`)},
+		{"REST API", regexp.MustCompile(`(?i)\brest\s*api\b`)},
+		{"GraphQL", regexp.MustCompile(`(?i)\bgraphql\b`)},
+		{"Microservices", regexp.MustCompile(`(?i)\bmicroservices?\b`)},
+	}
+
+	taggedLocationRegex   = regexp.MustCompile(`[📍📌]\s*([^\n|•]{2,80})`)
+	explicitLocationRegex = regexp.MustCompile(`(?i)^(location|dia diem|địa điểm|based in|onsite in|hybrid in|work location)\b`)
+)
+
+// post is one Threads post, read from the embedded JSON, a GraphQL response or the DOM
+type post struct {
+	ID        string
+	Text      string
+	Username  string
+	URL       string
+	Timestamp int64 //unix seconds, 0 when unknown
+}
+
+// parsePostsJSON decodes one script tag or GraphQL body; invalid JSON yields no posts
+func parsePostsJSON(data []byte) []post {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil
+	}
+	return extractPosts(v)
+}
+
+// extractPosts walks a decoded payload and returns every object shaped like a post
+// ({pk, caption, user}), including the ones wrapped in a "post" key
+func extractPosts(data any) []post {
+	var posts []post
+	var walk func(v any, depth int)
+	walk = func(v any, depth int) {
+		if depth > maxJSONDepth {
+			return
+		}
+		switch node := v.(type) {
+		case []any:
+			for _, item := range node {
+				walk(item, depth+1)
+			}
+		case map[string]any:
+			_, hasCaption := node["caption"]
+			if node["pk"] != nil && hasCaption && node["user"] != nil {
+				if p, ok := toPost(node); ok {
+					posts = append(posts, p)
+				}
+				return
+			}
+			wrapped, ok := node["post"].(map[string]any)
+			unwrapped := ok && wrapped["pk"] != nil && wrapped["user"] != nil
+			if unwrapped {
+				if p, ok := toPost(wrapped); ok {
+					posts = append(posts, p)
+				}
+			}
+			for key, child := range node {
+				if key == "__typename" || key == "viewer" || key == "extensions" || (key == "post" && unwrapped) {
+					continue
+				}
+				walk(child, depth+1)
+			}
+		}
+	}
+	walk(data, 0)
+	return posts
+}
+
+func toPost(node map[string]any) (post, bool) {
+	id := jsonString(node["id"])
+	if id == "" {
+		id = jsonString(node["pk"])
+	}
+	user, _ := node["user"].(map[string]any)
+	username := jsonString(user["username"])
+	if id == "" || username == "" {
+		return post{}, false
+	}
+
+	text := ""
+	if caption, ok := node["caption"].(map[string]any); ok {
+		text = jsonString(caption["text"])
+	}
+	if text == "" {
+		text = jsonString(node["text"])
+	}
+	if text == "" && node["image_versions2"] == nil {
+		return post{}, false
+	}
+
+	code := jsonString(node["code"])
+	if code == "" {
+		code = id
+	}
+	timestamp := jsonInt(node["taken_at"])
+	if timestamp == 0 {
+		timestamp = jsonInt(node["timestamp"])
+	}
+
+	return post{
+		ID:        id,
+		Text:      text,
+		Username:  username,
+		URL:       fmt.Sprintf("https://www.threads.com/@%s/post/%s", username, code),
+		Timestamp: timestamp,
+	}, true
+}
+
+// toJob applies the Node filters (Golang relevance, hiring intent, 60-day cutoff,
+// location) and builds the job; false when the post is dropped
+func toJob(p post, now time.Time) (scraper.Job, bool) {
+	text := strings.TrimSpace(p.Text)
+	normalized := utils.NormalizeText(text)
+
+	if !relevantRegex.MatchString(normalized) {
+		return scraper.Job{}, false
+	}
+	//Threads search is noisy, require explicit hiring intent
+	if !filter.IsPotentialJobPost(text) {
+		return scraper.Job{}, false
+	}
+
+	postedDate := "N/A"
+	if p.Timestamp > 0 {
+		postedAt := time.Unix(p.Timestamp, 0)
+		if now.Sub(postedAt) > maxPostAge {
+			return scraper.Job{}, false
+		}
+		postedDate = postedAt.Format("2006-01-02")
+	}
+
+	locationSignal := extractLocationSignal(text)
+	if filter.AnalyzeLocation(locationSignal+" "+text).HanoiOnly() || filter.HasExplicitNonPreferredLocation(locationSignal) {
+		return scraper.Job{}, false
+	}
+
+	title := firstLine(text, 100)
+	if title == "" {
+		title = "Golang Opportunity"
+	}
+
+	return scraper.Job{
+		Title:       title,
+		Company:     "@" + p.Username,
+		URL:         p.URL,
+		Location:    canonicalizeLocation(locationSignal),
+		Salary:      extractSalary(text),
+		Techstack:   extractTechStack(text),
+		Description: truncate(text, 5000),
+		Source:      "Threads",
+		PostedDate:  postedDate,
+		MatchScore:  internalScore(text, normalized),
+	}, true
+}
+
+// extractSalary returns the first amount in the post ("25-35tr", "$1,500", "20.000.000 vnđ")
+// or "Negotiable"
+func extractSalary(text string) string {
+	if negotiableRegex.MatchString(utils.NormalizeText(text)) {
+		return "Negotiable"
+	}
+	for _, regex := range moneyRegexes {
+		if match := regex.FindStringSubmatch(text); match != nil {
+			return cleanValue(match[1])
+		}
+	}
+	return "Negotiable"
+}
+
+// extractTechStack lists up to 6 technologies mentioned in the post, "Unknown" when none
+func extractTechStack(text string) string {
+	normalized := utils.NormalizeText(text)
+	var stack []string
+	for _, signal := range techSignals {
+		if signal.regex.MatchString(normalized) {
+			stack = append(stack, signal.label)
+		}
+		if len(stack) == 6 {
+			break
+		}
+	}
+	if len(stack) == 0 {
+		return "Unknown"
+	}
+	return strings.Join(stack, ", ")
+}
+
+// extractLocationSignal returns the preferred city when the text mentions one,
+// otherwise the 📍-tagged or "Location:" value as written, else "Unknown"
+func extractLocationSignal(text string) string {
+	if info := filter.AnalyzeLocation(text); info.Preferred != "Unknown" {
+		return info.Preferred
+	}
+	if match := taggedLocationRegex.FindStringSubmatch(text); match != nil {
+		return cleanValue(match[1])
+	}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if explicitLocationRegex.MatchString(line) {
+			return cleanValue(explicitLocationRegex.ReplaceAllString(line, ""))
+		}
+	}
+	return "Unknown"
+}
+
+// canonicalizeLocation keeps only the cities the pipeline knows (HCM, Can Tho, Remote, ...)
+func canonicalizeLocation(signal string) string {
+	return filter.AnalyzeLocation(signal).Preferred
+}
+
+// internalScore is the Node calculateInternalScore, the pipeline rescores later
+func internalScore(text, normalized string) int {
+	score := 5
+	if strings.Contains(normalized, "golang") {
+		score += 2
+	}
+	if strings.Contains(normalized, "backend") || strings.Contains(normalized, "back-end") {
+		score++
+	}
+	if strings.Contains(normalized, "cloud") || strings.Contains(normalized, "aws") || strings.Contains(normalized, "docker") {
+		score++
+	}
+	if fresherRegex.MatchString(normalized) {
+		score++
+	}
+	if extractSalary(text) != "Negotiable" {
+		score++
+	}
+	if extractLocationSignal(text) != "Unknown" {
+		score++
+	}
+	if score > 10 {
+		return 10
+	}
+	return score
+}
+
+func jsonString(v any) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case float64:
+		return strconv.FormatFloat(s, 'f', -1, 64)
+	}
+	return ""
+}
+
+func jsonInt(v any) int64 {
+	switch n := v.(type) {
+	case float64:
+		return int64(n)
+	case string:
+		i, _ := strconv.ParseInt(n, 10, 64)
+		return i
+	}
+	return 0
+}
+
+func cleanValue(value string) string {
+	value = strings.Trim(value, " \t\n:,-")
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// firstLine returns the first non-empty line, cut to max runes
+func firstLine(text string, max int) string {
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return truncate(line, max)
+		}
+	}
+	return ""
+}
+
+func truncate(s string, max int) string {
+	r := []rune(s)
+	if len(r) <= max {
+		return s
+	}
+	return strings.TrimSpace(string(r[:max]))
+}
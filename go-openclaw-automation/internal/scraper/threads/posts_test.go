@@ -0,0 +1,141 @@
+package threads
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToJob_NodeSamples(t *testing.T) {
+	now := time.Now()
+
+	//same samples as testing/test-threads-heuristics.js
+	realJobPost := `
+        We are hiring a Junior Golang Developer
+        Location: Remote
+        Salary: 25-35tr
+        Send CV to jobs@example.com
+    `
+	job, ok := toJob(post{ID: "1", Text: realJobPost, Username: "gotech_hr", URL: "https://www.threads.com/@gotech_hr/post/A1"}, now)
+	if assert.True(t, ok, "real hiring post should pass") {
+		assert.Equal(t, "We are hiring a Junior Golang Developer", job.Title)
+		assert.Equal(t, "@gotech_hr", job.Company)
+		assert.Equal(t, "Remote", job.Location)
+		assert.Equal(t, "25-35tr", job.Salary)
+		assert.Equal(t, "Go/Golang", job.Techstack)
+		assert.Equal(t, "Threads", job.Source)
+		assert.Equal(t, "N/A", job.PostedDate)
+	}
+
+	_, ok = toJob(post{ID: "2", Text: "SwiftUI x golang my pick", Username: "ios_dev"}, now)
+	assert.False(t, ok, "non-job discussion should be rejected")
+
+	_, ok = toJob(post{ID: "3", Text: "Open to work Golang backend developer intern, here is my CV", Username: "me"}, now)
+	assert.False(t, ok, "candidate-seeking post should be rejected")
+}
+
+func TestToJob_Filters(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	hiring := "We're hiring a Golang intern in HCM, send your CV"
+
+	job, ok := toJob(post{ID: "1", Text: hiring, Username: "a", Timestamp: now.Add(-48 * time.Hour).Unix()}, now)
+	if assert.True(t, ok) {
+		assert.Equal(t, "2026-10-14", job.PostedDate)
+		assert.Equal(t, "HCM", job.Location)
+	}
+
+	_, ok = toJob(post{ID: "2", Text: hiring, Username: "a", Timestamp: now.Add(-61 * 24 * time.Hour).Unix()}, now)
+	assert.False(t, ok, "posts older than 60 days are dropped")
+
+	_, ok = toJob(post{ID: "3", Text: "Tuyển dụng Golang fresher tại Hà Nội, gửi CV", Username: "a"}, now)
+	assert.False(t, ok, "Hanoi-only posts are dropped")
+
+	_, ok = toJob(post{ID: "4", Text: "We're hiring a Golang intern, send your CV\n📍 Đà Nẵng", Username: "a"}, now)
+	assert.False(t, ok, "posts with another explicit city are dropped")
+
+	_, ok = toJob(post{ID: "5", Text: "We're hiring a Go intern, send your CV", Username: "a"}, now)
+	assert.False(t, ok, "posts without a strict Golang mention are dropped")
+}
+
+func TestExtractSalary(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"Salary: 25-35tr", "25-35tr"},
+		{"Up to $1,500 for juniors", "$1,500"},
+		{"Lương 20.000.000 vnđ", "20.000.000 vnđ"},
+		{"Lương thỏa thuận, 15tr cho fresher", "Negotiable"},
+		{"No amount given", "Negotiable"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, extractSalary(tt.text), tt.text)
+	}
+}
+
+func TestExtractTechStack(t *testing.T) {
+	assert.Equal(t, "Go/Golang, Docker, PostgreSQL, gRPC", extractTechStack("Golang backend with Docker, Postgres and gRPC"))
+	assert.Equal(t, "Unknown", extractTechStack("Hiring now"))
+}
+
+func TestExtractLocationSignal(t *testing.T) {
+	assert.Equal(t, "Can Tho", extractLocationSignal("Golang intern tại Cần Thơ"))
+	assert.Equal(t, "Đà Nẵng", extractLocationSignal("Golang job\n📍 Đà Nẵng | full-time"))
+	assert.Equal(t, "Singapore", extractLocationSignal("Golang job\nLocation: Singapore"))
+	assert.Equal(t, "Unknown", extractLocationSignal("Golang job"))
+}
+
+func TestParsePostsJSON(t *testing.T) {
+	data, err := os.ReadFile("testdata/replay/search.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := []byte(`{"require"`)
+	body := data[indexOf(data, start):]
+	body = body[:indexOf(body, []byte(`</script>`))]
+
+	posts := parsePostsJSON(body)
+	if assert.Len(t, posts, 4) {
+		ids := make(map[string]post)
+		for _, p := range posts {
+			ids[p.ID] = p
+		}
+		first := ids["3401_77"]
+		assert.Equal(t, "gotech_hr", first.Username)
+		assert.Equal(t, "https://www.threads.com/@gotech_hr/post/DGoHire1", first.URL)
+		assert.Equal(t, int64(0), first.Timestamp)
+		assert.Equal(t, int64(1600000000), ids["3402_78"].Timestamp)
+	}
+
+	assert.Empty(t, parsePostsJSON([]byte(`{"broken": `)))
+}
+
+func TestDomPost(t *testing.T) {
+	p, ok := domPost("/@cantho_team/post/DDomOnly/", "/@cantho_team", "cantho_team\n5h\nTeam minh can them 1 ban Golang backend intern")
+	if assert.True(t, ok) {
+		assert.Equal(t, "DDomOnly", p.ID)
+		assert.Equal(t, "cantho_team", p.Username)
+		assert.Equal(t, "https://www.threads.com/@cantho_team/post/DDomOnly/", p.URL)
+		assert.Equal(t, "Team minh can them 1 ban Golang backend intern", p.Text)
+	}
+
+	p, ok = domPost("https://www.threads.com/@gotech_hr/post/DGoHire1?xmt=1", "", "text")
+	if assert.True(t, ok) {
+		assert.Equal(t, "DGoHire1", p.ID)
+		assert.Equal(t, "gotech_hr", p.Username)
+	}
+
+	_, ok = domPost("/@cantho_team", "", "text")
+	assert.False(t, ok)
+}
+
+func indexOf(data, sub []byte) int {
+	for i := 0; i+len(sub) <= len(data); i++ {
+		if string(data[i:i+len(sub)]) == string(sub) {
+			return i
+		}
+	}
+	return -1
+}
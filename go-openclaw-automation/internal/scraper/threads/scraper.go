@@ -0,0 +1,312 @@
+// Search Threads with the saved cookies
+// Read posts from the embedded JSON and GraphQL responses (DOM as fallback)
+// Keep hiring posts only
+
+package threads
+
+import (
+	"context"
+	"fmt"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper"
+	"go-openclaw-automation/internal/scraper/selectors"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// "5h", "2d", "3w" headers of rendered posts
+var relativeTimeRegex = regexp.MustCompile(`^\d{1,3}[smhdw]$`)
+
+const (
+	//scroll rounds per keyword (max_pages in config.yaml)
+	defaultMaxScrolls = 8
+	//stop scrolling a keyword after this many rounds without a new job
+	maxIdleScrolls = 3
+)
+
+type ThreadsScraper struct {
+	cfg       *config.Config
+	sel       *selectors.Pack
+	challenge *browser.ChallengeDetector
+}
+
+func init() {
+	scraper.Register("threads", func(cfg *config.Config) scraper.Scraper {
+		return NewThreadsScraper(cfg)
+	})
+}
+
+func NewThreadsScraper(cfg *config.Config) *ThreadsScraper {
+	sel := selectors.ForPlatform(cfg.SelectorsPath, "threads")
+	return &ThreadsScraper{
+		cfg:       cfg,
+		sel:       sel,
+		challenge: scraper.NewChallengeDetector(cfg, "threads", browser.ChallengeOptions{LoginSelector: sel.CSS("auth.instagram_button")}),
+	}
+}
+
+func (s *ThreadsScraper) Name() string {
+	return "Threads"
+}
+
+func (s *ThreadsScraper) Scrape(ctx context.Context, browserCtx playwright.BrowserContext) ([]scraper.Job, error) {
+	return scraper.Collect(ctx, s, browserCtx)
+}
+
+// ScrapeStream searches recent posts for every social keyword and sends the hiring ones.
+// A login wall (expired cookies) stops the run with an error.
+func (s *ThreadsScraper) ScrapeStream(ctx context.Context, browserCtx playwright.BrowserContext, out chan<- scraper.Job) error {
+	log.Printf("🧵 Searching Threads (Authenticated)... (selectors v%s)", s.sel.Version)
+
+	page, err := browserCtx.NewPage()
+	if err != nil {
+		return fmt.Errorf("threads: failed to create page: %w", err)
+	}
+	defer page.Close()
+
+	//GraphQL search results loaded while scrolling, read between scroll rounds
+	responses := &capturedResponses{}
+	page.OnResponse(responses.capture)
+
+	log.Println("  🔐 Checking authentication status...")
+	resp, err := browser.Goto(ctx, page, "https://www.threads.com/", playwright.PageGotoOptions{
+		WaitUntil: playwright.WaitUntilStateDomcontentloaded,
+		Timeout:   playwright.Float(30000),
+	})
+	if err != nil {
+		log.Printf("  ⚠️ Login check failed: %v", err)
+	} else {
+		browser.RandomDelay(2000, 4000)
+		if err := s.challenge.Check(ctx, page, resp); err != nil {
+			return fmt.Errorf("threads: %w", err)
+		}
+	}
+
+	maxScrolls := s.cfg.Platform("threads").MaxPages
+	if maxScrolls <= 0 {
+		maxScrolls = defaultMaxScrolls
+	}
+
+	seenPosts := make(map[string]bool)
+	seenURLs := make(map[string]bool)
+	for _, keyword := range searchKeywords(s.cfg) {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := s.searchKeyword(ctx, page, keyword, maxScrolls, responses, seenPosts, seenURLs, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// searchKeyword runs one recent-posts search and scrolls until maxScrolls rounds
+// or maxIdleScrolls rounds in a row without a new job
+func (s *ThreadsScraper) searchKeyword(ctx context.Context, page playwright.Page, keyword string, maxScrolls int, responses *capturedResponses, seenPosts, seenURLs map[string]bool, out chan<- scraper.Job) error {
+	log.Printf("  🔍 Searching: %q", keyword)
+	responses.reset()
+
+	searchURL := "https://www.threads.com/search?q=" + url.QueryEscape(keyword) + "&serp_type=default&filter=recent"
+	resp, err := browser.Goto(ctx, page, searchURL, playwright.PageGotoOptions{
+		WaitUntil: playwright.WaitUntilStateDomcontentloaded,
+		Timeout:   playwright.Float(30000),
+	})
+	if err != nil {
+		log.Printf("    ⚠️ Navigation failed: %v", err)
+		return nil
+	}
+	browser.RandomDelay(3000, 6000)
+	browser.MouseJiggle(page)
+
+	if err := s.challenge.Check(ctx, page, resp); err != nil {
+		return fmt.Errorf("threads: searching %q: %w", keyword, err)
+	}
+
+	found, idle := 0, 0
+	for round := 0; round < maxScrolls && idle < maxIdleScrolls; round++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		newJobs := 0
+		now := time.Now()
+		for _, p := range s.collectPosts(page, responses) {
+			if seenPosts[p.ID] {
+				continue
+			}
+			seenPosts[p.ID] = true
+
+			job, ok := toJob(p, now)
+			if !ok || seenURLs[job.URL] {
+				continue
+			}
+			seenURLs[job.URL] = true
+			newJobs++
+			log.Printf("      📝 %s", truncate(job.Title, 40))
+			if err := scraper.Send(ctx, out, job); err != nil {
+				return err
+			}
+		}
+
+		if newJobs > 0 {
+			found += newJobs
+			idle = 0
+			log.Printf("    ⬇️ Filtered & verified %d relevant posts (Total: %d)", newJobs, found)
+		} else {
+			idle++
+		}
+
+		browser.HumanScroll(page)
+		browser.RandomDelay(3000, 6000)
+	}
+	return nil
+}
+
+// collectPosts reads the posts currently known to the page: embedded JSON first,
+// then captured GraphQL responses, then the rendered post containers
+func (s *ThreadsScraper) collectPosts(page playwright.Page, responses *capturedResponses) []post {
+	var posts []post
+
+	scripts, err := s.sel.OnPage(page, "search.data_script").AllTextContents()
+	if err == nil {
+		for _, script := range scripts {
+			posts = append(posts, parsePostsJSON([]byte(script))...)
+		}
+	}
+	for _, body := range responses.all() {
+		posts = append(posts, parsePostsJSON(body)...)
+	}
+
+	return append(posts, s.domPosts(page)...)
+}
+
+// domPosts is the fallback when Threads stops embedding JSON
+func (s *ThreadsScraper) domPosts(page playwright.Page) []post {
+	containers, err := s.sel.OnPage(page, "search.post").All()
+	if err != nil {
+		return nil
+	}
+
+	var posts []post
+	for _, container := range containers {
+		text, err := container.InnerText(playwright.LocatorInnerTextOptions{Timeout: playwright.Float(1000)})
+		if err != nil || len(strings.TrimSpace(text)) < 5 {
+			continue
+		}
+
+		href, _ := s.sel.In(container, "post.link").First().GetAttribute("href", playwright.LocatorGetAttributeOptions{
+			Timeout: playwright.Float(1000),
+		})
+		userHref, _ := s.sel.In(container, "post.author_link").First().GetAttribute("href", playwright.LocatorGetAttributeOptions{
+			Timeout: playwright.Float(1000),
+		})
+		p, ok := domPost(href, userHref, text)
+		if !ok {
+			continue
+		}
+
+		if datetime, err := s.sel.In(container, "post.time").First().GetAttribute("datetime", playwright.LocatorGetAttributeOptions{
+			Timeout: playwright.Float(1000),
+		}); err == nil {
+			if t, err := time.Parse(time.RFC3339, datetime); err == nil {
+				p.Timestamp = t.Unix()
+			}
+		}
+		posts = append(posts, p)
+	}
+	return posts
+}
+
+// domPost builds a post from its permalink ("/@user/post/CODE"). The author comes from
+// the profile link, else the handle in the permalink; the header lines (author, "5h")
+// that innerText puts before the post text are dropped.
+func domPost(href, userHref, text string) (post, bool) {
+	_, code, ok := strings.Cut(href, "/post/")
+	if !ok {
+		return post{}, false
+	}
+	code = strings.Trim(code, "/")
+	if i := strings.IndexAny(code, "/?"); i >= 0 {
+		code = code[:i]
+	}
+	if code == "" {
+		return post{}, false
+	}
+
+	username := strings.Trim(strings.TrimPrefix(userHref, "/@"), "/")
+	if username == "" {
+		username = "unknown"
+		if _, rest, ok := strings.Cut(href, "/@"); ok {
+			if handle, _, _ := strings.Cut(rest, "/"); handle != "" {
+				username = handle
+			}
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	for len(lines) > 1 {
+		line := strings.TrimSpace(lines[0])
+		if line != "" && line != username && !relativeTimeRegex.MatchString(line) {
+			break
+		}
+		lines = lines[1:]
+	}
+
+	postURL := href
+	if !strings.HasPrefix(postURL, "http") {
+		postURL = "https://www.threads.com" + postURL
+	}
+	return post{ID: code, Text: strings.Join(lines, "\n"), Username: username, URL: postURL}, true
+}
+
+// authRequired reports the login redirect or the "Continue with Instagram" interstitial
+// searchKeywords: social keywords, falling back to the job keywords
+func searchKeywords(cfg *config.Config) []string {
+	if len(cfg.SocialKeywords) > 0 {
+		return cfg.SocialKeywords
+	}
+	return cfg.Keywords
+}
+
+// capturedResponses collects GraphQL JSON bodies; Playwright calls capture from its own goroutine
+type capturedResponses struct {
+	mu     sync.Mutex
+	bodies [][]byte
+}
+
+func (c *capturedResponses) capture(response playwright.Response) {
+	u := response.URL()
+	if !strings.Contains(u, "/api/graphql") && !strings.Contains(u, "searchResults") && !strings.Contains(u, "search_serp") {
+		return
+	}
+	if !strings.Contains(response.Headers()["content-type"], "application/json") {
+		return
+	}
+	body, err := response.Body()
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.bodies = append(c.bodies, body)
+}
+
+func (c *capturedResponses) all() [][]byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([][]byte(nil), c.bodies...)
+}
+
+func (c *capturedResponses) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.bodies = nil
+}
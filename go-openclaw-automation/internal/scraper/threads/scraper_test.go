@@ -0,0 +1,75 @@
+package threads
+
+import (
+	"context"
+	"errors"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper/scrapertest"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchKeywords(t *testing.T) {
+	cfg := &config.Config{Keywords: []string{"golang"}}
+	assert.Equal(t, []string{"golang"}, searchKeywords(cfg))
+
+	cfg.SocialKeywords = []string{"go backend"}
+	assert.Equal(t, []string{"go backend"}, searchKeywords(cfg))
+}
+
+// TestThreadsScraper_Scrape_LoginWall verifies that the Instagram interstitial stops the scraper with an error
+func TestThreadsScraper_Scrape_LoginWall(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+
+	mockHTML := `<html><title>Threads</title><body><div role="button">Continue with Instagram</div></body></html>`
+	if err := browserCtx.Route("**/*", func(route playwright.Route) {
+		route.Fulfill(playwright.RouteFulfillOptions{
+			Status: playwright.Int(200),
+			Body:   mockHTML,
+		})
+	}); err != nil {
+		t.Fatalf("could not set up route interception: %v", err)
+	}
+
+	cfg := &config.Config{Keywords: []string{"golang"}}
+	cfg.Challenge = config.ChallengeRules{EvidenceDir: t.TempDir()}
+	jobs, err := NewThreadsScraper(cfg).Scrape(context.Background(), browserCtx)
+
+	assert.True(t, errors.Is(err, browser.ErrLoginRequired), "got %v", err)
+	assert.Empty(t, jobs)
+}
+
+// TestThreadsScraper_Scrape_Replay serves a recorded search from testdata/replay (no network).
+// The embedded JSON has a hiring post, an old one, a "my pick" thread and a Hanoi-only post;
+// the DOM adds one post that is not in the JSON.
+// Re-record with: SCRAPERTEST_RECORD=1 go test -run TestThreadsScraper_Scrape_Replay ./internal/scraper/threads/
+func TestThreadsScraper_Scrape_Replay(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+	scrapertest.Attach(t, browserCtx, "testdata/replay", scrapertest.ModeFromEnv())
+
+	cfg := &config.Config{
+		Keywords: []string{"golang"},
+		EnabledPlatforms: map[string]config.PlatformConfig{
+			"threads": {Enabled: true, MaxPages: 1},
+		},
+	}
+	jobs, err := NewThreadsScraper(cfg).Scrape(context.Background(), browserCtx)
+
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 2) {
+		job := jobs[0]
+		assert.Equal(t, "We are hiring a Junior Golang Developer", job.Title)
+		assert.Equal(t, "@gotech_hr", job.Company)
+		assert.Equal(t, "https://www.threads.com/@gotech_hr/post/DGoHire1", job.URL)
+		assert.Equal(t, "Remote", job.Location)
+		assert.Equal(t, "25-35tr", job.Salary)
+		assert.Equal(t, "Go/Golang, Docker, PostgreSQL", job.Techstack)
+
+		assert.Equal(t, "@cantho_team", jobs[1].Company)
+		assert.Equal(t, "https://www.threads.com/@cantho_team/post/DDomOnly", jobs[1].URL)
+		assert.Equal(t, "Can Tho", jobs[1].Location)
+	}
+}
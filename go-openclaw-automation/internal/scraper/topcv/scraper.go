@@ -0,0 +1,352 @@
+package topcv
+
+import (
+	"context"
+	"fmt"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper"
+	"go-openclaw-automation/internal/scraper/selectors"
+	"go-openclaw-automation/utils"
+	"log"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+type TopCVScraper struct {
+	cfg       *config.Config
+	details   *scraper.DetailFetcher //tab pool for detail pages
+	seen      scraper.SeenFunc       //jobs stored by previous runs (nil = none)
+	sel       *selectors.Pack
+	challenge *browser.ChallengeDetector
+}
+
+func init() {
+	scraper.Register("topcv", func(cfg *config.Config) scraper.Scraper {
+		return NewTopCVScraper(cfg)
+	})
+}
+
+func NewTopCVScraper(cfg *config.Config) *TopCVScraper {
+	sel := selectors.ForPlatform(cfg.SelectorsPath, "topcv")
+	return &TopCVScraper{
+		cfg:       cfg,
+		details:   scraper.NewDetailFetcher(0, 0),
+		sel:       sel,
+		challenge: scraper.NewChallengeDetector(cfg, "topcv", browser.ChallengeOptions{CaptchaSelector: sel.CSS("search.captcha")}),
+	}
+}
+
+func (s *TopCVScraper) Name() string {
+	return "TopCV"
+}
+
+// SetSeen lets pagination stop early on pages that only hold already-seen jobs
+func (s *TopCVScraper) SetSeen(seen scraper.SeenFunc) {
+	s.seen = seen
+}
+
+// SetDetailFetcher shares the runner's tab pool
+func (s *TopCVScraper) SetDetailFetcher(details *scraper.DetailFetcher) {
+	s.details = details
+}
+
+// readJobDetail fills a copy of the card's job from its detail page: JSON-LD JobPosting
+// first; only when that has no description are the two DOM description sections
+// extracted and merged.
+func readJobDetail(sel *selectors.Pack, job scraper.Job) scraper.ReadDetail {
+	return func(detailPage playwright.Page) (scraper.Job, error) {
+		//structured data gives real posted dates and salaries
+		if posting, ok := scraper.FindJobPosting(scraper.ExtractJobPostings(detailPage), job.Title); ok {
+			posting.Apply(&job)
+		}
+		if job.Description != "" {
+			return job, nil
+		}
+
+		// Two sections to merge: job description + candidate requirements
+		var parts []string
+		for _, name := range []string{"detail.description", "detail.requirements"} {
+			text, err := sel.OnPage(detailPage, name).First().TextContent(playwright.LocatorTextContentOptions{
+				Timeout: playwright.Float(5000),
+			})
+			if err == nil {
+				if text = strings.TrimSpace(text); text != "" {
+					parts = append(parts, text)
+				}
+			}
+		}
+
+		job.Description = strings.Join(parts, "\n\n---\n\n")
+		return job, nil
+	}
+}
+
+func (s *TopCVScraper) Scrape(ctx context.Context, browserCtx playwright.BrowserContext) ([]scraper.Job, error) {
+	return scraper.Collect(ctx, s, browserCtx)
+}
+
+// ScrapeStream sends each job to out as soon as its description is fetched
+func (s *TopCVScraper) ScrapeStream(ctx context.Context, browserCtx playwright.BrowserContext, out chan<- scraper.Job) error {
+	log.Printf("📋 Searching TopCV.vn... (selectors v%s)", s.sel.Version)
+	seenURLs := make(map[string]bool)
+
+	//init page
+	page, err := browserCtx.NewPage()
+	if err != nil {
+		return fmt.Errorf("topcv: failed to create page: %w", err)
+	}
+	defer page.Close()
+
+	//warmup phase
+	log.Println("🏠 Navigating to TopCV Home for warm-up...")
+	resp, err := browser.Goto(ctx, page, "https://www.topcv.vn/", playwright.PageGotoOptions{
+		WaitUntil: playwright.WaitUntilStateDomcontentloaded,
+		Timeout:   playwright.Float(30000),
+	})
+	if err != nil {
+		log.Printf("⚠️ Warm-up navigation failed: %v", err)
+	}
+	//a blocked homepage means every search is blocked too
+	if err := s.challenge.Check(ctx, page, resp); err != nil {
+		return fmt.Errorf("topcv: homepage: %w", err)
+	}
+
+	//simulate reading/interacting
+	warmUpDuration := time.Duration(rand.Intn(5000)+5000) * time.Millisecond
+	log.Printf("⏳ Warming up for %v...", warmUpDuration)
+	time.Sleep(warmUpDuration)
+
+	//define exp levels. 1: No exp, 2: <1 year, 3: 1 year
+	expLevels := []int{1, 2, 3}
+
+	//page and card budget shared by every search of this run
+	pager := scraper.PaginatorFor(s.cfg, "topcv", s.seen)
+
+	//loop through keywords from config
+	for _, keyword := range s.cfg.Keywords {
+		for _, exp := range expLevels {
+			//slugify keyword: "golang developer" -> "golang-developer"
+			slug := strings.ReplaceAll(strings.ToLower(keyword), " ", "-")
+
+			//results are sorted newest first, so stop paging once a page is empty or fully seen
+			for pageNum := 1; pager.HasPage(pageNum); pageNum++ {
+				//check context cancellation
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+
+				//construct URL
+				url := fmt.Sprintf("https://www.topcv.vn/tim-viec-lam-%s-tai-ho-chi-minh-kl2?exp=%d&sort=new&type_keyword=1&sba=1&locations=l2_l20&saturday_status=0", slug, exp)
+				if pageNum > 1 {
+					url += fmt.Sprintf("&page=%d", pageNum)
+				}
+				log.Printf("  🔍 Searching: %s (Exp: %d, Page: %d) - Cần Thơ & HCM", keyword, exp, pageNum)
+
+				cards, seenCards, err := s.searchPage(ctx, browserCtx, page, url, keyword, pager, seenURLs, out)
+				if err != nil {
+					return err
+				}
+				if pager.Done(cards, seenCards) {
+					break
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// searchPage loads one search results page and streams its matching jobs.
+// It returns the number of cards on the page and how many of them were already seen;
+// 0 cards means the page was empty or failed to load. A challenge that does not clear is an error.
+func (s *TopCVScraper) searchPage(ctx context.Context, browserCtx playwright.BrowserContext, page playwright.Page, url, keyword string, pager *scraper.Paginator, seenURLs map[string]bool, out chan<- scraper.Job) (int, int, error) {
+	//stealth headers
+	page.SetExtraHTTPHeaders(map[string]string{})
+	page.SetExtraHTTPHeaders(map[string]string{
+		"Referer": "https://www.topcv.vn/",
+	})
+
+	//navigate
+	resp, err := browser.Goto(ctx, page, url, playwright.PageGotoOptions{
+		WaitUntil: playwright.WaitUntilStateDomcontentloaded,
+		Timeout:   playwright.Float(30000),
+	})
+	if err != nil {
+		log.Printf("⚠️ Error navigating to %s: %v", url, err)
+		return 0, 0, nil
+	}
+
+	//Cloudflare, CAPTCHA or rate limit: the next searches would hit it too
+	if err := s.challenge.Check(ctx, page, resp); err != nil {
+		return 0, 0, fmt.Errorf("topcv: search %q: %w", keyword, err)
+	}
+
+	//human behavior
+	browser.RandomDelay(1000, 2000)
+	browser.MouseJiggle(page)
+	browser.RandomDelay(500, 1000)
+
+	//check no suitable jobs to fail fast
+	if visible, _ := s.sel.OnPage(page, "search.no_results").IsVisible(); visible {
+		return 0, 0, nil
+	}
+
+	//get job cards
+	jobCards, err := s.sel.OnPage(page, "search.card").All()
+	if len(jobCards) == 0 {
+		return 0, 0, nil
+	}
+	if err != nil {
+		log.Printf("    ⚠️ Error finding job cards: %v", err)
+		return 0, 0, nil
+	}
+	log.Printf("    📦 Found %d job cards for '%s'", len(jobCards), keyword)
+
+	//handle popups/modals: the survey shows up a moment after the results, if at all
+	surveyModal := s.sel.OnPage(page, "search.survey_modal")
+	surveyModal.First().WaitFor(playwright.LocatorWaitForOptions{
+		State:   playwright.WaitForSelectorStateVisible,
+		Timeout: playwright.Float(3000),
+	})
+	if visible, _ := surveyModal.IsVisible(); visible {
+		log.Println("      ⚠️ Survey modal detected. Closing...")
+		s.sel.OnPage(page, "search.survey_cancel").Click()
+		surveyModal.WaitFor(playwright.LocatorWaitForOptions{
+			State:   playwright.WaitForSelectorStateHidden,
+			Timeout: playwright.Float(2000),
+		})
+	}
+
+	//Spawn goroutines OUTSIDE the card loop — one per valid card
+	//WaitGroup + buffered channel to collect results from all goroutines concurrently
+	var wg sync.WaitGroup
+	results := make(chan scraper.Job, len(jobCards))
+	seenCards := 0
+
+	//loop and extract card metadata sequentially (Playwright page is NOT thread-safe)
+	for _, card := range jobCards {
+		if rand.Float32() > 0.8 {
+			browser.RandomDelay(100, 300)
+		}
+
+		// The title <a> tag also contains the job detail URL
+		titleEl := s.sel.In(card, "card.title_link").First()
+		title, _ := titleEl.TextContent()
+		urlVal, _ := titleEl.GetAttribute("href")
+
+		//already stored by a previous run: no need to open its detail tab
+		if pager.Seen(urlVal) {
+			seenCards++
+			continue
+		}
+		//repeated across keywords, exp levels and pages: only the first copy takes a card and a detail tab
+		if seenURLs[urlVal] {
+			continue
+		}
+		seenURLs[urlVal] = true
+
+		companyEl := s.sel.In(card, "card.company").First()
+		company, _ := companyEl.TextContent()
+
+		salaryEl := s.sel.In(card, "card.salary").First()
+		salary, err := salaryEl.TextContent(playwright.LocatorTextContentOptions{
+			Timeout: playwright.Float(100),
+		})
+		if err != nil {
+			salary = "Negotiable"
+		}
+
+		locationEl := s.sel.In(card, "card.location").First()
+		location, _ := locationEl.TextContent()
+
+		//"Cập nhật 2 ngày trước"; the detail page's JSON-LD datePosted wins when it has one
+		updated := ""
+		if count, _ := s.sel.In(card, "card.updated").Count(); count > 0 {
+			updated, _ = s.sel.In(card, "card.updated").First().TextContent()
+		}
+
+		//clean data
+		title = strings.TrimSpace(title)
+		company = strings.TrimSpace(company)
+		location = strings.TrimSpace(location)
+		salary = strings.TrimSpace(salary)
+
+		if title == "" {
+			continue
+		}
+
+		//normalize text and filtering
+		fullText := utils.NormalizeText(title + " " + company)
+		if !strings.Contains(fullText, "go") && !strings.Contains(fullText, "golang") {
+			continue
+		}
+
+		//exclude keywords
+		isExcluded := false
+		for _, excluded := range s.cfg.ExcludeKeywords {
+			if excluded == "" {
+				continue
+			}
+			if strings.Contains(fullText, strings.ToLower(excluded)) {
+				isExcluded = true
+				log.Printf("🚫 Skipped excluded keyword '%s': %s", excluded, title)
+				break
+			}
+		}
+
+		if isExcluded {
+			continue
+		}
+
+		//card budget for the whole run
+		if !pager.TakeCard() {
+			log.Println("    🛑 TopCV card budget reached.")
+			break
+		}
+
+		// Spawn goroutine to fetch description concurrently (the tab pool limits parallelism)
+		// Function parameters capture the current values — safe goroutine variable capture
+		wg.Add(1)
+		go func(cardTitle, cardURL, cardCompany, cardSalary, cardLocation, cardPosted string) {
+			defer wg.Done()
+			log.Printf("      🔎 Fetching description for: %s", cardTitle)
+			job := scraper.Job{
+				Title:      cardTitle,
+				Company:    cardCompany,
+				Salary:     cardSalary,
+				Location:   cardLocation,
+				URL:        cardURL,
+				Source:     "TopCV",
+				PostedDate: cardPosted,
+				Techstack:  "Golang",
+			}
+			if detailed, err := s.details.Fetch(ctx, browserCtx, job.URL, readJobDetail(s.sel, job)); err != nil {
+				log.Printf("      ⚠️ %v", err)
+			} else {
+				job = detailed
+			}
+			results <- job
+		}(title, urlVal, company, salary, location, utils.PostedDate(updated, time.Now()))
+	}
+
+	// After ALL cards are processed: wait for goroutines, then collect
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	//results is buffered for every card, so returning early never blocks the goroutines
+	for job := range results {
+		log.Printf("      ✅ %s - %s", job.Title, job.Company)
+		if err := scraper.Send(ctx, out, job); err != nil {
+			return len(jobCards), seenCards, err
+		}
+	}
+
+	return len(jobCards), seenCards, nil
+}
@@ -0,0 +1,123 @@
+package topcv
+
+import (
+	"context"
+	"errors"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper/scrapertest"
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTopCVScraper_Scrape_Cloudflare verifies that when every response looks like a
+// Cloudflare block page, Scrape returns 0 jobs and an error wrapping browser.ErrBlocked
+// once the challenge has not cleared within the (shortened) wait.
+//
+// Uses Playwright Route interception to intercept all network requests inside the
+// BrowserContext and return a fake Cloudflare HTML response — no real network needed.
+func TestTopCVScraper_Scrape_Cloudflare(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+
+	// Intercept ALL requests in this context and return a fake Cloudflare block page
+	mockHTML := `<html><title>Attention Required! | Cloudflare</title><body><h1>Please verify you are a human</h1></body></html>`
+	if err := browserCtx.Route("**/*", func(route playwright.Route) {
+		route.Fulfill(playwright.RouteFulfillOptions{
+			Status: playwright.Int(200),
+			Body:   mockHTML,
+		})
+	}); err != nil {
+		t.Fatalf("could not set up route interception: %v", err)
+	}
+
+	cfg := &config.Config{Keywords: []string{"test"}}
+	cfg.Challenge = config.ChallengeRules{Wait: time.Second, RecheckInterval: 200 * time.Millisecond, EvidenceDir: t.TempDir()}
+	scraper := NewTopCVScraper(cfg)
+
+	jobs, err := scraper.Scrape(context.Background(), browserCtx)
+
+	assert.True(t, errors.Is(err, browser.ErrBlocked), "Scrape should stop with ErrBlocked when Cloudflare blocks, got %v", err)
+	assert.Equal(t, 0, len(jobs), "Should return 0 jobs when Cloudflare blocks everything")
+}
+
+// TestTopCVScraper_Scrape_NoJobs verifies that when the search results page shows
+// the ".none-suitable-job" element, Scrape returns 0 jobs gracefully.
+func TestTopCVScraper_Scrape_NoJobs(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+
+	// Return a page that looks like a valid TopCV page but with no jobs
+	mockHTML := `<html><title>TopCV</title><body><div class="none-suitable-job">Không tìm thấy việc làm phù hợp</div></body></html>`
+	if err := browserCtx.Route("**/*", func(route playwright.Route) {
+		route.Fulfill(playwright.RouteFulfillOptions{
+			Status: playwright.Int(200),
+			Body:   mockHTML,
+		})
+	}); err != nil {
+		t.Fatalf("could not set up route interception: %v", err)
+	}
+
+	cfg := &config.Config{Keywords: []string{"golang"}}
+	scraper := NewTopCVScraper(cfg)
+
+	jobs, err := scraper.Scrape(context.Background(), browserCtx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(jobs), "Should return 0 jobs when no-jobs element is visible")
+}
+
+// TestTopCVScraper_Scrape_Replay serves recorded pages from testdata/replay (no network)
+// and checks card parsing, the "go" title filter, the merged description sections
+// and that pagination stops on the empty page 2.
+// Re-record with: SCRAPERTEST_RECORD=1 go test -run TestTopCVScraper_Scrape_Replay ./internal/scraper/topcv/
+func TestTopCVScraper_Scrape_Replay(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+	scrapertest.Attach(t, browserCtx, "testdata/replay", scrapertest.ModeFromEnv())
+
+	cfg := &config.Config{Keywords: []string{"golang"}}
+	scraper := NewTopCVScraper(cfg)
+
+	jobs, err := scraper.Scrape(context.Background(), browserCtx)
+
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 1, "Java card should be filtered out and exp levels deduped") {
+		job := jobs[0]
+		assert.Equal(t, "Junior Golang Developer", job.Title)
+		assert.Equal(t, "Công ty ABC Tech", job.Company)
+		assert.Equal(t, "10 - 15 triệu", job.Salary)
+		assert.Equal(t, "Hồ Chí Minh", job.Location)
+		assert.Equal(t, "https://www.topcv.vn/viec-lam/junior-golang-developer/1001.html", job.URL)
+		assert.Equal(t, time.Now().AddDate(0, 0, -2).Format("2006-01-02"), job.PostedDate, "read from the card's update label")
+		assert.Equal(t, "Xây dựng microservices bằng Golang.\n\n---\n\nCó kiến thức Docker, REST API.", job.Description)
+	}
+}
+
+// TestTopCVScraper_Scrape_Real is an integration test that hits the real TopCV website.
+//
+// Why testing.Short()?
+// Go's test runner supports a "-short" flag (go test -short ./...) that signals
+// "skip any slow or external-dependency tests". Integration tests that open a real
+// browser and make real network calls should always check testing.Short() and skip,
+// so that CI pipelines can run fast unit tests without needing network access or time.
+//
+// Run this test manually with: go test -v -run TestTopCVScraper_Scrape_Real ./internal/scraper/topcv/
+func TestTopCVScraper_Scrape_Real(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode (-short flag). Run without -short to execute.")
+	}
+
+	browserCtx := scrapertest.NewBrowserContext(t)
+
+	cfg := &config.Config{
+		Keywords: []string{"golang"},
+	}
+	scraper := NewTopCVScraper(cfg)
+
+	jobs, err := scraper.Scrape(context.Background(), browserCtx)
+
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, len(jobs), 0, "Should return a non-negative number of jobs")
+	t.Logf("Real scrape returned %d jobs", len(jobs))
+}
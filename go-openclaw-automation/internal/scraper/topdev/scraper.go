@@ -0,0 +1,358 @@
+package topdev
+
+import (
+	"context"
+	"fmt"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper"
+	"go-openclaw-automation/internal/scraper/selectors"
+	"go-openclaw-automation/utils"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// TopDev job levels, scraped one after the other like the Node scraper
+var jobLevels = []struct {
+	ID   string
+	Name string
+}{
+	{ID: "1616", Name: "Intern"},
+	{ID: "1617", Name: "Fresher"},
+}
+
+// regions maps normalized config locations to TopDev region ids (province codes)
+var regions = []struct {
+	ID      string
+	Name    string //as shown on job cards
+	Aliases []string
+}{
+	{ID: "79", Name: "Hồ Chí Minh", Aliases: []string{"ho chi minh", "hcm", "sai gon", "saigon"}},
+	{ID: "92", Name: "Cần Thơ", Aliases: []string{"can tho"}},
+	{ID: "01", Name: "Hà Nội", Aliases: []string{"ha noi", "hanoi"}},
+	{ID: "48", Name: "Đà Nẵng", Aliases: []string{"da nang", "danang"}},
+}
+
+// zeroResultsRegex matches the page title TopDev uses when a search has no positions
+var zeroResultsRegex = regexp.MustCompile(`(?i)Recruiting\s+0\s+`)
+
+type TopDevScraper struct {
+	cfg       *config.Config
+	details   *scraper.DetailFetcher //tab pool for detail pages
+	seen      scraper.SeenFunc       //jobs stored by previous runs (nil = none)
+	sel       *selectors.Pack
+	challenge *browser.ChallengeDetector
+}
+
+func init() {
+	scraper.Register("topdev", func(cfg *config.Config) scraper.Scraper {
+		return NewTopDevScraper(cfg)
+	})
+}
+
+func NewTopDevScraper(cfg *config.Config) *TopDevScraper {
+	return &TopDevScraper{
+		cfg:       cfg,
+		details:   scraper.NewDetailFetcher(0, 0),
+		sel:       selectors.ForPlatform(cfg.SelectorsPath, "topdev"),
+		challenge: scraper.NewChallengeDetector(cfg, "topdev", browser.ChallengeOptions{}),
+	}
+}
+
+func (s *TopDevScraper) Name() string {
+	return "TopDev"
+}
+
+// SetSeen lets pagination stop early on pages that only hold already-seen jobs
+func (s *TopDevScraper) SetSeen(seen scraper.SeenFunc) {
+	s.seen = seen
+}
+
+// SetDetailFetcher shares the runner's tab pool
+func (s *TopDevScraper) SetDetailFetcher(details *scraper.DetailFetcher) {
+	s.details = details
+}
+
+func (s *TopDevScraper) Scrape(ctx context.Context, browserCtx playwright.BrowserContext) ([]scraper.Job, error) {
+	return scraper.Collect(ctx, s, browserCtx)
+}
+
+// ScrapeStream searches every keyword × job level in the configured regions and
+// sends each job as soon as its detail page is read
+func (s *TopDevScraper) ScrapeStream(ctx context.Context, browserCtx playwright.BrowserContext, out chan<- scraper.Job) error {
+	log.Printf("📋 Searching TopDev.vn... (selectors v%s)", s.sel.Version)
+	seenURLs := make(map[string]bool)
+
+	page, err := browserCtx.NewPage()
+	if err != nil {
+		return fmt.Errorf("topdev: failed to create page: %w", err)
+	}
+	defer page.Close()
+
+	regionIDs, regionNames := searchRegions(s.cfg.Locations)
+
+	//page and card budget shared by every search of this run
+	pager := scraper.PaginatorFor(s.cfg, "topdev", s.seen)
+
+	for _, keyword := range s.cfg.Keywords {
+		for _, level := range jobLevels {
+			for pageNum := 1; pager.HasPage(pageNum); pageNum++ {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if pager.Exhausted() {
+					log.Println("    🛑 TopDev card budget reached.")
+					return nil
+				}
+
+				searchURL := fmt.Sprintf("https://topdev.vn/jobs/search?keyword=%s&page=%d&region_ids=%s&job_levels_ids=%s",
+					url.QueryEscape(keyword), pageNum, url.QueryEscape(strings.Join(regionIDs, ",")), level.ID)
+				log.Printf("  🔍 Searching: %s (%s, Page: %d) - %s", keyword, level.Name, pageNum, strings.Join(regionNames, "/"))
+
+				cards, seenCards, err := s.searchPage(ctx, browserCtx, page, searchURL, keyword, pager, seenURLs, out)
+				if err != nil {
+					return err
+				}
+				if pager.Done(cards, seenCards) {
+					break
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// searchPage loads one results page and streams its jobs.
+// It returns the number of cards on the page and how many of them were already seen.
+func (s *TopDevScraper) searchPage(ctx context.Context, browserCtx playwright.BrowserContext, page playwright.Page, searchURL, keyword string, pager *scraper.Paginator, seenURLs map[string]bool, out chan<- scraper.Job) (int, int, error) {
+	resp, err := browser.Goto(ctx, page, searchURL, playwright.PageGotoOptions{
+		WaitUntil: playwright.WaitUntilStateDomcontentloaded,
+		Timeout:   playwright.Float(60000),
+	})
+	if err != nil {
+		log.Printf("    ⚠️ Navigation failed: %v", err)
+		return 0, 0, nil
+	}
+
+	//Cloudflare blocks the whole site, no point trying the other searches
+	if err := s.challenge.Check(ctx, page, resp); err != nil {
+		return 0, 0, fmt.Errorf("topdev: %w", err)
+	}
+
+	title, _ := page.Title()
+	if zeroResultsRegex.MatchString(title) {
+		log.Println("    ⏭️ Skipping: 0 positions found according to page title")
+		return 0, 0, nil
+	}
+
+	//human behavior
+	browser.RandomDelay(800, 1500)
+	browser.MouseJiggle(page)
+
+	//"Jobs you may be interested in" means no exact matches, only suggestions
+	if count, _ := page.Locator(s.sel.CSS("search.suggestion")).Count(); count > 0 {
+		log.Println("    ⚠️ Only suggested jobs, no exact matches")
+		return 0, 0, nil
+	}
+
+	page.WaitForSelector(s.sel.CSS("search.card"), playwright.PageWaitForSelectorOptions{
+		Timeout: playwright.Float(5000),
+	})
+	cards, err := s.sel.OnPage(page, "search.card").All()
+	if err != nil || len(cards) == 0 {
+		log.Printf("    ⚠️ No job cards found for %q", keyword)
+		return 0, 0, nil
+	}
+	log.Printf("    📦 Found %d job cards", len(cards))
+
+	var wg sync.WaitGroup
+	results := make(chan scraper.Job, len(cards))
+	seenCards := 0
+
+	//card metadata is read sequentially (Playwright page is NOT thread-safe),
+	//detail pages are fetched concurrently in their own tabs
+	for _, card := range cards {
+		job, ok := s.parseCard(card)
+		if !ok {
+			continue
+		}
+		if pager.Seen(job.URL) {
+			seenCards++
+			continue
+		}
+		if seenURLs[job.URL] {
+			continue
+		}
+		seenURLs[job.URL] = true
+
+		if !pager.TakeCard() {
+			log.Println("    🛑 TopDev card budget reached.")
+			break
+		}
+
+		wg.Add(1)
+		go func(job scraper.Job) {
+			defer wg.Done()
+			if detailed, err := s.details.Fetch(ctx, browserCtx, job.URL, s.readJobDetail(job)); err != nil {
+				log.Printf("      ⚠️ %v", err)
+			} else {
+				job = detailed
+			}
+			results <- job
+		}(job)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for job := range results {
+		//the search matches company pages too, keep jobs that mention the keyword
+		kLower := strings.ToLower(keyword)
+		if !strings.Contains(strings.ToLower(job.Title), kLower) && !strings.Contains(strings.ToLower(job.Description), kLower) {
+			continue
+		}
+		log.Printf("      ✅ %s - %s", job.Title, job.Company)
+		if err := scraper.Send(ctx, out, job); err != nil {
+			return len(cards), seenCards, err
+		}
+	}
+	return len(cards), seenCards, nil
+}
+
+// parseCard reads the list card; false when it has no job link
+func (s *TopDevScraper) parseCard(card playwright.Locator) (scraper.Job, bool) {
+	titleEl := s.sel.In(card, "card.title_link").First()
+	title, _ := titleEl.TextContent()
+	href, _ := titleEl.GetAttribute("href")
+	title = strings.TrimSpace(title)
+	if title == "" || href == "" {
+		return scraper.Job{}, false
+	}
+	if !strings.HasPrefix(href, "http") {
+		href = "https://topdev.vn" + href
+	}
+	if idx := strings.Index(href, "?"); idx != -1 {
+		href = href[:idx]
+	}
+
+	company, _ := s.sel.In(card, "card.company").First().TextContent()
+	salary, err := s.sel.In(card, "card.salary").First().TextContent(playwright.LocatorTextContentOptions{
+		Timeout: playwright.Float(100),
+	})
+	if err != nil || strings.TrimSpace(salary) == "" {
+		salary = "Negotiable"
+	}
+
+	//location is one of the line-clamp spans, the one naming a city
+	spans, _ := s.sel.In(card, "card.location").AllTextContents()
+	location := ""
+	for _, text := range spans {
+		if cityOf(text) != "" {
+			location = strings.TrimSpace(text)
+			break
+		}
+	}
+
+	//"2 ngày trước" / "3 days ago"
+	postedDate := "Recent"
+	if count, _ := s.sel.In(card, "card.posted").Count(); count > 0 {
+		if posted, err := s.sel.In(card, "card.posted").First().TextContent(); err == nil {
+			postedDate = utils.PostedDate(posted, time.Now())
+		}
+	}
+
+	return scraper.Job{
+		Title:      title,
+		Company:    strings.TrimSpace(strings.Replace(company, "Logo", "", 1)),
+		URL:        href,
+		Salary:     strings.TrimSpace(salary),
+		Location:   location,
+		Source:     "TopDev",
+		Techstack:  "Golang",
+		PostedDate: postedDate,
+	}, true
+}
+
+// readJobDetail fills a copy of the card's job from its detail page; JSON-LD JobPosting
+// first, the description panel text only when structured data has no description
+func (s *TopDevScraper) readJobDetail(job scraper.Job) scraper.ReadDetail {
+	return func(detailPage playwright.Page) (scraper.Job, error) {
+		if posting, ok := scraper.FindJobPosting(scraper.ExtractJobPostings(detailPage), job.Title); ok {
+			posting.Apply(&job)
+		}
+		if job.Description != "" {
+			return job, nil
+		}
+
+		text, err := s.sel.OnPage(detailPage, "detail.description").First().InnerText(playwright.LocatorInnerTextOptions{
+			Timeout: playwright.Float(5000),
+		})
+		if err == nil {
+			job.Description = truncate(strings.TrimSpace(text), 5000)
+		}
+		return job, nil
+	}
+}
+
+// searchRegions maps config locations to TopDev region ids, HCM + Cần Thơ when none match
+func searchRegions(locations []string) ([]string, []string) {
+	var ids, names []string
+	for _, loc := range locations {
+		normalized := utils.NormalizeText(loc)
+		for _, region := range regions {
+			if containsAny(normalized, region.Aliases) && !contains(ids, region.ID) {
+				ids = append(ids, region.ID)
+				names = append(names, region.Name)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return []string{"79", "92"}, []string{"Hồ Chí Minh", "Cần Thơ"}
+	}
+	return ids, names
+}
+
+// cityOf returns the region name mentioned in text, empty when none
+func cityOf(text string) string {
+	normalized := utils.NormalizeText(text)
+	for _, region := range regions {
+		if strings.Contains(normalized, utils.NormalizeText(region.Name)) || containsAny(normalized, region.Aliases) {
+			return region.Name
+		}
+	}
+	return ""
+}
+
+func containsAny(s string, subs []string) bool {
+	for _, sub := range subs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func truncate(s string, max int) string {
+	r := []rune(s)
+	if len(r) <= max {
+		return s
+	}
+	return string(r[:max])
+}
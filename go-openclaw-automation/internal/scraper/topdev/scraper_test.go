@@ -0,0 +1,83 @@
+package topdev
+
+import (
+	"context"
+	"errors"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper/scrapertest"
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchRegions(t *testing.T) {
+	ids, names := searchRegions([]string{"remote", "cần thơ", "can tho", "ho chi minh", "hcm"})
+	assert.Equal(t, []string{"92", "79"}, ids)
+	assert.Equal(t, []string{"Cần Thơ", "Hồ Chí Minh"}, names)
+
+	ids, _ = searchRegions([]string{"remote"})
+	assert.Equal(t, []string{"79", "92"}, ids, "defaults to HCM + Cần Thơ")
+}
+
+// TestTopDevScraper_Scrape_Cloudflare verifies that a Cloudflare interstitial stops the scraper with ErrBlocked
+func TestTopDevScraper_Scrape_Cloudflare(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+
+	mockHTML := `<html><title>Just a moment...</title><body>Checking your browser</body></html>`
+	if err := browserCtx.Route("**/*", func(route playwright.Route) {
+		route.Fulfill(playwright.RouteFulfillOptions{
+			Status: playwright.Int(200),
+			Body:   mockHTML,
+		})
+	}); err != nil {
+		t.Fatalf("could not set up route interception: %v", err)
+	}
+
+	cfg := &config.Config{Keywords: []string{"golang"}}
+	cfg.Challenge = config.ChallengeRules{Wait: time.Second, RecheckInterval: 200 * time.Millisecond, EvidenceDir: t.TempDir()}
+	jobs, err := NewTopDevScraper(cfg).Scrape(context.Background(), browserCtx)
+
+	assert.True(t, errors.Is(err, browser.ErrBlocked), "got %v", err)
+	assert.Empty(t, jobs)
+}
+
+// TestTopDevScraper_Scrape_Replay serves recorded pages from testdata/replay (no network).
+// Intern search: a JSON-LD job, a DOM-only job and a PHP card (dropped by the keyword check);
+// Fresher search only has suggestions; page 2 is empty.
+// Re-record with: SCRAPERTEST_RECORD=1 go test -run TestTopDevScraper_Scrape_Replay ./internal/scraper/topdev/
+func TestTopDevScraper_Scrape_Replay(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+	scrapertest.Attach(t, browserCtx, "testdata/replay", scrapertest.ModeFromEnv())
+
+	cfg := &config.Config{Keywords: []string{"golang"}, Locations: []string{"ho chi minh", "can tho"}}
+	jobs, err := NewTopDevScraper(cfg).Scrape(context.Background(), browserCtx)
+
+	assert.NoError(t, err)
+	if !assert.Len(t, jobs, 2) {
+		return
+	}
+
+	byTitle := map[string]int{}
+	for i, job := range jobs {
+		byTitle[job.Title] = i
+	}
+
+	structured := jobs[byTitle["Junior Golang Developer"]]
+	assert.Equal(t, "ABC Tech", structured.Company)
+	assert.Equal(t, "15 - 25 triệu", structured.Salary)
+	assert.Equal(t, "Thành phố Hồ Chí Minh, Hồ Chí Minh", structured.Location)
+	assert.Equal(t, "https://topdev.vn/detail-jobs/junior-golang-developer-abc-tech-2001", structured.URL, "query params should be stripped")
+	assert.Equal(t, "2026-10-10", structured.PostedDate)
+	assert.Equal(t, "Build payment APIs in Golang.\nDocker", structured.Description)
+	assert.Equal(t, "TopDev", structured.Source)
+
+	dom := jobs[byTitle["Golang Intern"]]
+	assert.Equal(t, "Gopher Co", dom.Company)
+	assert.Equal(t, "Negotiable", dom.Salary)
+	assert.Equal(t, "Quận Ninh Kiều, Cần Thơ", dom.Location)
+	assert.Equal(t, time.Now().AddDate(0, 0, -2).Format("2006-01-02"), dom.PostedDate, "relative card date")
+	assert.Equal(t, "Learn Golang and gRPC with our backend team.", dom.Description)
+}
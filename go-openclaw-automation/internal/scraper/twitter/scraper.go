@@ -0,0 +1,228 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/filter"
+	"go-openclaw-automation/internal/scraper"
+	"go-openclaw-automation/internal/scraper/selectors"
+	"go-openclaw-automation/utils"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// maxTweetsPerQuery matches the Node scraper: only the newest tweets of each live search
+const maxTweetsPerQuery = 30
+
+var (
+	//only posts that look like a job are kept, AI validation happens later in the pipeline
+	jobWordRegex = regexp.MustCompile(`(?i)\b(hiring|job|opening|developer|engineer|position|remote|golang|go backend|go developer|backend role)\b`)
+
+	taggedLocationRegex   = regexp.MustCompile(`[📍📌]\s*([^\n|•]{2,80})`)
+	explicitLocationRegex = regexp.MustCompile(`(?i)^(location|dia diem|địa điểm|based in|onsite in|hybrid in|work location)\b`)
+)
+
+type TwitterScraper struct {
+	cfg       *config.Config
+	sel       *selectors.Pack
+	challenge *browser.ChallengeDetector
+}
+
+func init() {
+	scraper.Register("twitter", func(cfg *config.Config) scraper.Scraper {
+		return NewTwitterScraper(cfg)
+	})
+}
+
+func NewTwitterScraper(cfg *config.Config) *TwitterScraper {
+	sel := selectors.ForPlatform(cfg.SelectorsPath, "twitter")
+	return &TwitterScraper{
+		cfg:       cfg,
+		sel:       sel,
+		challenge: scraper.NewChallengeDetector(cfg, "twitter", browser.ChallengeOptions{LoginSelector: sel.CSS("search.login_form")}),
+	}
+}
+
+func (s *TwitterScraper) Name() string {
+	return "Twitter"
+}
+
+func (s *TwitterScraper) Scrape(ctx context.Context, browserCtx playwright.BrowserContext) ([]scraper.Job, error) {
+	return scraper.Collect(ctx, s, browserCtx)
+}
+
+// ScrapeStream runs one live search per keyword with the logged-in cookies of
+// browserCtx and sends every hiring-looking tweet as a job
+func (s *TwitterScraper) ScrapeStream(ctx context.Context, browserCtx playwright.BrowserContext, out chan<- scraper.Job) error {
+	log.Printf("🐦 Searching X (Twitter)... (selectors v%s)", s.sel.Version)
+	seenURLs := make(map[string]bool)
+
+	page, err := browserCtx.NewPage()
+	if err != nil {
+		return fmt.Errorf("twitter: failed to create page: %w", err)
+	}
+	defer page.Close()
+
+	for _, query := range searchQueries(s.cfg) {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log.Printf("  🔍 Query: %s", query)
+
+		searchURL := "https://x.com/search?q=" + url.QueryEscape(query) + "&f=live"
+		resp, err := browser.Goto(ctx, page, searchURL, playwright.PageGotoOptions{
+			WaitUntil: playwright.WaitUntilStateDomcontentloaded,
+			Timeout:   playwright.Float(60000),
+		})
+		if err != nil {
+			log.Printf("    ⚠️ Navigation failed: %v", err)
+			continue
+		}
+		browser.RandomDelay(1000, 2000)
+
+		page.WaitForSelector(s.sel.CSS("search.tweet"), playwright.PageWaitForSelectorOptions{
+			Timeout: playwright.Float(10000),
+		})
+
+		//expired cookies land on the login form, every other query would too
+		if err := s.challenge.Check(ctx, page, resp); err != nil {
+			return fmt.Errorf("twitter: %w", err)
+		}
+
+		browser.HumanScroll(page)
+
+		tweets, err := s.sel.OnPage(page, "search.tweet").All()
+		if err != nil {
+			log.Printf("    ⚠️ Error getting tweets: %v", err)
+			continue
+		}
+		log.Printf("    📦 Found %d tweets", len(tweets))
+
+		for i, tweet := range tweets {
+			if i >= maxTweetsPerQuery {
+				break
+			}
+			job, ok := s.parseTweet(tweet)
+			if !ok || seenURLs[job.URL] {
+				continue
+			}
+			seenURLs[job.URL] = true
+			log.Printf("      📝 %s", truncate(job.Title, 40))
+			if err := scraper.Send(ctx, out, job); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// parseTweet turns a tweet into a raw job; false for short, non-job or Hanoi-only posts
+func (s *TwitterScraper) parseTweet(tweet playwright.Locator) (scraper.Job, bool) {
+	text, err := s.sel.In(tweet, "tweet.text").First().TextContent(playwright.LocatorTextContentOptions{
+		Timeout: playwright.Float(1000),
+	})
+	text = strings.TrimSpace(text)
+	if err != nil || len([]rune(text)) < 20 {
+		return scraper.Job{}, false
+	}
+
+	statusLink, _ := s.sel.In(tweet, "tweet.status_link").First().GetAttribute("href", playwright.LocatorGetAttributeOptions{
+		Timeout: playwright.Float(1000),
+	})
+	if statusLink == "" {
+		return scraper.Job{}, false
+	}
+	postURL := statusLink
+	if !strings.HasPrefix(postURL, "http") {
+		postURL = "https://x.com" + postURL
+	}
+
+	authorHref, _ := s.sel.In(tweet, "tweet.author_link").First().GetAttribute("href", playwright.LocatorGetAttributeOptions{
+		Timeout: playwright.Float(1000),
+	})
+	author := "Twitter Post"
+	if handle := strings.Trim(authorHref, "/"); handle != "" {
+		author = "@" + handle
+	}
+
+	postedDate := "N/A"
+	if datetime, err := s.sel.In(tweet, "tweet.time").First().GetAttribute("datetime", playwright.LocatorGetAttributeOptions{
+		Timeout: playwright.Float(1000),
+	}); err == nil {
+		if t, err := time.Parse(time.RFC3339, datetime); err == nil {
+			postedDate = t.Format("2006-01-02")
+		}
+	}
+
+	normalized := utils.NormalizeText(text)
+	if !jobWordRegex.MatchString(normalized) {
+		return scraper.Job{}, false
+	}
+	location := extractLocation(text)
+	if location == "Hanoi" {
+		return scraper.Job{}, false
+	}
+
+	return scraper.Job{
+		Title:       truncate(text, 100) + "...",
+		Company:     author,
+		URL:         postURL,
+		Location:    location,
+		Description: text,
+		Source:      "Twitter",
+		Techstack:   "Go/Golang",
+		PostedDate:  postedDate,
+	}, true
+}
+
+// searchQueries builds the live search queries: each keyword plus hiring terms
+func searchQueries(cfg *config.Config) []string {
+	keywords := cfg.SocialKeywords
+	if len(keywords) == 0 {
+		keywords = cfg.Keywords
+	}
+	queries := make([]string, 0, len(keywords))
+	for _, keyword := range keywords {
+		queries = append(queries, fmt.Sprintf(`"%s" (job OR hiring OR opening OR recruiter OR careers OR apply)`, keyword))
+	}
+	return queries
+}
+
+// extractLocation returns HCM / Can Tho / Remote / Global when the text mentions one,
+// "Hanoi" for Hanoi-only posts, then a 📍-tagged or "Location:" line, else "Unknown"
+func extractLocation(text string) string {
+	if info := filter.AnalyzeLocation(text); info.Preferred != "Unknown" {
+		return info.Preferred
+	}
+
+	if match := taggedLocationRegex.FindStringSubmatch(text); match != nil {
+		return cleanLocation(match[1])
+	}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if explicitLocationRegex.MatchString(line) {
+			return cleanLocation(explicitLocationRegex.ReplaceAllString(line, ""))
+		}
+	}
+	return "Unknown"
+}
+
+func cleanLocation(value string) string {
+	value = strings.Trim(value, " \t:,-")
+	return strings.Join(strings.Fields(value), " ")
+}
+
+func truncate(s string, max int) string {
+	r := []rune(s)
+	if len(r) <= max {
+		return s
+	}
+	return strings.TrimSpace(string(r[:max]))
+}
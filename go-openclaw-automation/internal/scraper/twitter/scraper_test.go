@@ -0,0 +1,87 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/scraper/scrapertest"
+	"testing"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractLocation(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"Hiring Golang dev at our Sài Gòn office", "HCM"},
+		{"Golang intern - Cần Thơ", "Can Tho"},
+		{"Go developer, work from home", "Remote"},
+		{"Golang engineer, onsite in Ha Noi only", "Hanoi"},
+		{"Golang job\n📍 Đà Nẵng | full-time", "Đà Nẵng"},
+		{"Golang job\nLocation: Singapore", "Singapore"},
+		{"Golang job, apply now", "Unknown"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, extractLocation(tt.text), tt.text)
+	}
+}
+
+func TestSearchQueries(t *testing.T) {
+	cfg := &config.Config{Keywords: []string{"golang"}}
+	assert.Equal(t, []string{`"golang" (job OR hiring OR opening OR recruiter OR careers OR apply)`}, searchQueries(cfg))
+
+	cfg.SocialKeywords = []string{"go backend"}
+	assert.Equal(t, []string{`"go backend" (job OR hiring OR opening OR recruiter OR careers OR apply)`}, searchQueries(cfg))
+}
+
+// TestTwitterScraper_Scrape_LoginWall verifies that expired cookies stop the scraper with an error
+func TestTwitterScraper_Scrape_LoginWall(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+
+	mockHTML := `<html><title>Log in to X</title><body><div data-testid="LoginForm"></div></body></html>`
+	if err := browserCtx.Route("**/*", func(route playwright.Route) {
+		route.Fulfill(playwright.RouteFulfillOptions{
+			Status: playwright.Int(200),
+			Body:   mockHTML,
+		})
+	}); err != nil {
+		t.Fatalf("could not set up route interception: %v", err)
+	}
+
+	cfg := &config.Config{Keywords: []string{"golang"}}
+	cfg.Challenge = config.ChallengeRules{EvidenceDir: t.TempDir()}
+	jobs, err := NewTwitterScraper(cfg).Scrape(context.Background(), browserCtx)
+
+	assert.True(t, errors.Is(err, browser.ErrLoginRequired), "got %v", err)
+	assert.Empty(t, jobs)
+}
+
+// TestTwitterScraper_Scrape_Replay serves a recorded live search from testdata/replay (no network).
+// The Hanoi-only and the too-short tweets are dropped.
+// Re-record with: SCRAPERTEST_RECORD=1 go test -run TestTwitterScraper_Scrape_Replay ./internal/scraper/twitter/
+func TestTwitterScraper_Scrape_Replay(t *testing.T) {
+	browserCtx := scrapertest.NewBrowserContext(t)
+	scrapertest.Attach(t, browserCtx, "testdata/replay", scrapertest.ModeFromEnv())
+
+	cfg := &config.Config{Keywords: []string{"golang"}}
+	jobs, err := NewTwitterScraper(cfg).Scrape(context.Background(), browserCtx)
+
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 2) {
+		job := jobs[0]
+		assert.Equal(t, "@abctech_jobs", job.Company)
+		assert.Equal(t, "https://x.com/abctech_jobs/status/1845000000000000001", job.URL)
+		assert.Equal(t, "2026-10-14", job.PostedDate)
+		assert.Equal(t, "HCM", job.Location)
+		assert.Equal(t, "Twitter", job.Source)
+		assert.Contains(t, job.Description, "Junior Golang Developer")
+
+		assert.Equal(t, "@remoteco", jobs[1].Company)
+		assert.Equal(t, "Remote", jobs[1].Location)
+		assert.Equal(t, "2026-10-11", jobs[1].PostedDate)
+	}
+}
@@ -0,0 +1,140 @@
+// Search VietnamWorks through the job search API its website uses
+// No browser needed: results come as JSON with structured salary and dates
+// Keep jobs in the configured locations and seniority levels
+
+package vietnamworks
+
+import (
+	"context"
+	"fmt"
+	"go-openclaw-automation/internal/browser"
+	"go-openclaw-automation/internal/config"
+	"go-openclaw-automation/internal/filter"
+	"go-openclaw-automation/internal/scraper"
+	"log"
+
+	"github.com/playwright-community/playwright-go"
+)
+
+// searchEndpoint is the public job search API behind www.vietnamworks.com
+const searchEndpoint = "https://ms.vietnamworks.com/job-search/v1.0/search"
+
+// hitsPerPage is the size of one API page
+const hitsPerPage = 50
+
+type VietnamWorksScraper struct {
+	cfg      *config.Config
+	client   *browser.HTTPClient
+	seen     scraper.SeenFunc //jobs stored by previous runs (nil = none)
+	endpoint string
+}
+
+func init() {
+	scraper.Register("vietnamworks", func(cfg *config.Config) scraper.Scraper {
+		return NewVietnamWorksScraper(cfg)
+	})
+}
+
+func NewVietnamWorksScraper(cfg *config.Config) *VietnamWorksScraper {
+	return &VietnamWorksScraper{
+		cfg:      cfg,
+		client:   browser.NewHTTPClient(0),
+		endpoint: searchEndpoint,
+	}
+}
+
+// NeedsBrowser is false: the search API is plain JSON
+func (s *VietnamWorksScraper) NeedsBrowser() bool {
+	return false
+}
+
+// SetHTTPClient shares the runner's cookie jar and response cache
+func (s *VietnamWorksScraper) SetHTTPClient(client *browser.HTTPClient) {
+	s.client = client
+}
+
+// SetSeen lets pagination stop early on pages that only hold already-seen jobs
+func (s *VietnamWorksScraper) SetSeen(seen scraper.SeenFunc) {
+	s.seen = seen
+}
+
+func (s *VietnamWorksScraper) Name() string {
+	return "VietnamWorks"
+}
+
+// Scrape searches every keyword; browserCtx is unused
+func (s *VietnamWorksScraper) Scrape(ctx context.Context, browserCtx playwright.BrowserContext) ([]scraper.Job, error) {
+	return scraper.Collect(ctx, s, browserCtx)
+}
+
+// ScrapeStream searches every keyword newest first and sends the jobs that match the
+// configured locations and levels. A failing API stops the run with an error.
+func (s *VietnamWorksScraper) ScrapeStream(ctx context.Context, browserCtx playwright.BrowserContext, out chan<- scraper.Job) error {
+	log.Println("🇻🇳 Searching VietnamWorks...")
+	seenURLs := make(map[string]bool)
+
+	opts := s.cfg.Platform("vietnamworks")
+	levels := opts.Levels
+	if len(levels) == 0 {
+		levels = defaultLevels
+	}
+
+	//page and card budget shared by every search of this run
+	pager := scraper.PaginatorFor(s.cfg, "vietnamworks", s.seen)
+
+	for _, keyword := range s.cfg.Keywords {
+		for pageNum := 1; pager.HasPage(pageNum); pageNum++ {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Printf("  🔍 Searching: %s (Page: %d)", keyword, pageNum)
+
+			data, err := s.client.PostJSON(ctx, s.endpoint, newSearchRequest(keyword, pageNum, hitsPerPage))
+			if err != nil {
+				return fmt.Errorf("vietnamworks: search %q failed: %w", keyword, err)
+			}
+			resp, err := parseSearch(data)
+			if err != nil {
+				return fmt.Errorf("vietnamworks: invalid search response: %w", err)
+			}
+
+			seenCards := 0
+			for _, result := range resp.Data {
+				job := toJob(result)
+				if job.URL == "" {
+					continue
+				}
+				if pager.Seen(job.URL) {
+					seenCards++
+					continue
+				}
+				if seenURLs[job.URL] {
+					continue
+				}
+				seenURLs[job.URL] = true
+
+				if !levelAllowed(result, levels) {
+					log.Printf("    ❌ Skipped (Level %s): %s", result.JobLevel, job.Title)
+					continue
+				}
+				if filter.IsExcludedLevel(job.Title) || filter.AnalyzeLocation(job.Location).HanoiOnly() || !locationAllowed(job.Location, s.cfg.Locations) {
+					continue
+				}
+				if !pager.TakeCard() {
+					log.Println("    🛑 VietnamWorks card budget reached.")
+					return nil
+				}
+
+				log.Printf("    ✅ %s - %s", job.Title, job.Company)
+				if err := scraper.Send(ctx, out, job); err != nil {
+					return err
+				}
+			}
+
+			if pager.Done(len(resp.Data), seenCards) || pageNum >= resp.Meta.NbPages {
+				break
+			}
+		}
+	}
+	return nil
+}
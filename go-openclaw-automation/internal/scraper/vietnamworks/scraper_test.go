@@ -0,0 +1,147 @@
+package vietnamworks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go-openclaw-automation/internal/config"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSearchServer serves testdata/search-page<N>.json for the requested 0-based page
+func newSearchServer(t *testing.T) (*httptest.Server, *[]searchRequest) {
+	t.Helper()
+	var requests []searchRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req searchRequest
+		if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&req) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		requests = append(requests, req)
+
+		data, err := os.ReadFile(fmt.Sprintf("testdata/search-page%d.json", req.Page+1))
+		if err != nil {
+			w.Write([]byte(`{"data": [], "meta": {"nbPages": 2}}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestVietnamWorksScraper_Scrape(t *testing.T) {
+	server, requests := newSearchServer(t)
+
+	cfg := &config.Config{
+		Keywords:  []string{"golang"},
+		Locations: []string{"ho chi minh", "cần thơ", "remote"},
+	}
+	s := NewVietnamWorksScraper(cfg)
+	s.endpoint = server.URL
+
+	jobs, err := s.Scrape(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, jobs, 3, "manager, Hanoi-only, Da Nang and duplicate jobs are dropped")
+
+	job := jobs[0]
+	assert.Equal(t, "Junior Golang Developer", job.Title)
+	assert.Equal(t, "ABC Tech", job.Company)
+	assert.Equal(t, "https://www.vietnamworks.com/junior-golang-developer-1900001-jv", job.URL)
+	assert.Equal(t, "Hồ Chí Minh", job.Location)
+	assert.Equal(t, "15000000 - 25000000 VND/month", job.Salary)
+	assert.Equal(t, "2026-10-10", job.PostedDate, "approvedOn is shown in Vietnam time")
+	assert.Equal(t, "Golang, PostgreSQL, Docker", job.Techstack)
+	assert.Equal(t, "Build payment APIs in Golang.\ngRPC services\n\n1 year of backend experience.", job.Description)
+	assert.Equal(t, "VietnamWorks", job.Source)
+
+	intern := jobs[1]
+	assert.Equal(t, "https://www.vietnamworks.com/golang-intern-1900002-jv", intern.URL, "link rebuilt from alias and id")
+	assert.Equal(t, "Negotiable", intern.Salary)
+	assert.Equal(t, "2026-10-08", intern.PostedDate)
+	assert.Equal(t, "Golang", intern.Techstack)
+
+	fresher := jobs[2]
+	assert.Equal(t, "Hồ Chí Minh; Hà Nội", fresher.Location)
+	assert.Equal(t, "500 - 800 USD/month", fresher.Salary)
+
+	require.Len(t, *requests, 2)
+	assert.Equal(t, "golang", (*requests)[0].Query)
+	assert.Equal(t, []searchOrder{{Field: "approvedOn", Value: "desc"}}, (*requests)[0].Order)
+	assert.Equal(t, 1, (*requests)[1].Page)
+}
+
+func TestVietnamWorksScraper_Scrape_Levels(t *testing.T) {
+	server, _ := newSearchServer(t)
+
+	cfg := &config.Config{
+		Keywords: []string{"golang"},
+		EnabledPlatforms: map[string]config.PlatformConfig{
+			"vietnamworks": {MaxPages: 1, Levels: []string{"intern"}},
+		},
+	}
+	s := NewVietnamWorksScraper(cfg)
+	s.endpoint = server.URL
+
+	jobs, err := s.Scrape(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "Golang Intern", jobs[0].Title)
+}
+
+func TestVietnamWorksScraper_Scrape_SeenStopsPaging(t *testing.T) {
+	server, requests := newSearchServer(t)
+
+	s := NewVietnamWorksScraper(&config.Config{Keywords: []string{"golang"}})
+	s.endpoint = server.URL
+	s.SetSeen(func(url string) bool { return true })
+
+	jobs, err := s.Scrape(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+	assert.Len(t, *requests, 1, "a page of only seen jobs ends the search")
+}
+
+func TestVietnamWorksScraper_Scrape_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	s := NewVietnamWorksScraper(&config.Config{Keywords: []string{"golang"}})
+	s.endpoint = server.URL
+
+	_, err := s.Scrape(context.Background(), nil)
+	assert.Error(t, err)
+}
+
+func TestLevelAllowed(t *testing.T) {
+	levels := []string{"intern", "fresher", "experienced"}
+
+	assert.True(t, levelAllowed(searchJob{JobLevel: "Experienced (Non-Manager)"}, levels))
+	assert.True(t, levelAllowed(searchJob{JobLevelVI: "Mới tốt nghiệp"}, levels))
+	assert.True(t, levelAllowed(searchJob{}, levels), "jobs without a level are kept")
+	assert.False(t, levelAllowed(searchJob{JobLevel: "Director and Above", JobLevelVI: "Giám đốc và Cấp cao hơn"}, levels))
+	assert.True(t, levelAllowed(searchJob{JobLevelVI: "Trưởng nhóm"}, []string{"Trưởng nhóm"}), "unknown names match label words")
+}
+
+func TestLocationAllowed(t *testing.T) {
+	locations := []string{"ho chi minh", "cần thơ", "remote"}
+
+	assert.True(t, locationAllowed("Quận 1, Hồ Chí Minh", locations))
+	assert.True(t, locationAllowed("Thủ Đức", locations), "districts map to their city")
+	assert.True(t, locationAllowed("TP.HCM", locations))
+	assert.True(t, locationAllowed("Làm việc từ xa", locations))
+	assert.True(t, locationAllowed("", locations), "jobs without a location are kept")
+	assert.False(t, locationAllowed("Cầu Giấy, Hà Nội", locations))
+	assert.False(t, locationAllowed("Đà Nẵng", locations))
+	assert.True(t, locationAllowed("Đà Nẵng", nil), "no configured locations keeps everything")
+}
@@ -0,0 +1,260 @@
+package vietnamworks
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-openclaw-automation/internal/filter"
+	"go-openclaw-automation/internal/scraper"
+	"go-openclaw-automation/utils"
+	"strings"
+	"time"
+)
+
+// maxDescriptionChars matches the detail-page scrapers
+const maxDescriptionChars = 5000
+
+// levelLabels maps config level names to words of VietnamWorks' level labels
+// (English "jobLevel" and Vietnamese "jobLevelVI", compared without accents)
+var levelLabels = map[string][]string{
+	"intern":      {"intern", "student", "thuc tap", "sinh vien"},
+	"fresher":     {"fresher", "entry", "new grad", "moi tot nghiep"},
+	"experienced": {"experienced", "non-manager", "nhan vien"},
+	"manager":     {"manager", "truong phong", "quan ly"},
+	"director":    {"director", "giam doc", "pho giam doc"},
+}
+
+// defaultLevels: the levels a junior candidate can apply to
+var defaultLevels = []string{"intern", "fresher", "experienced"}
+
+// searchRequest is the body of POST ms.vietnamworks.com/job-search/v1.0/search
+type searchRequest struct {
+	UserID         int           `json:"userId"`
+	Query          string        `json:"query"`
+	Filter         []any         `json:"filter"`
+	Ranges         []any         `json:"ranges"`
+	Order          []searchOrder `json:"order"`
+	HitsPerPage    int           `json:"hitsPerPage"`
+	Page           int           `json:"page"` //0-based
+	RetrieveFields []string      `json:"retrieveFields"`
+}
+
+type searchOrder struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
+// newSearchRequest asks for the newest jobs matching keyword (pageNum is 1-based)
+func newSearchRequest(keyword string, pageNum, hitsPerPage int) searchRequest {
+	return searchRequest{
+		Query:       keyword,
+		Filter:      []any{},
+		Ranges:      []any{},
+		Order:       []searchOrder{{Field: "approvedOn", Value: "desc"}},
+		HitsPerPage: hitsPerPage,
+		Page:        pageNum - 1,
+		RetrieveFields: []string{
+			"jobId", "jobTitle", "jobUrl", "alias", "companyName", "workingLocations",
+			"salaryMin", "salaryMax", "salaryCurrency", "isSalaryVisible", "prettySalary",
+			"approvedOn", "jobLevel", "jobLevelVI", "skills", "jobDescription", "jobRequirement",
+		},
+	}
+}
+
+// searchResponse is the part of the search API response we read
+type searchResponse struct {
+	Data []searchJob `json:"data"`
+	Meta struct {
+		NbHits  int `json:"nbHits"`
+		Page    int `json:"page"`
+		NbPages int `json:"nbPages"`
+	} `json:"meta"`
+}
+
+type searchJob struct {
+	JobID            int64   `json:"jobId"`
+	JobTitle         string  `json:"jobTitle"`
+	JobURL           string  `json:"jobUrl"`
+	Alias            string  `json:"alias"`
+	CompanyName      string  `json:"companyName"`
+	SalaryMin        float64 `json:"salaryMin"`
+	SalaryMax        float64 `json:"salaryMax"`
+	SalaryCurrency   string  `json:"salaryCurrency"`
+	IsSalaryVisible  bool    `json:"isSalaryVisible"`
+	PrettySalary     string  `json:"prettySalary"`
+	ApprovedOn       string  `json:"approvedOn"` //RFC 3339, the date the job went online
+	JobLevel         string  `json:"jobLevel"`
+	JobLevelVI       string  `json:"jobLevelVI"`
+	JobDescription   string  `json:"jobDescription"` //HTML
+	JobRequirement   string  `json:"jobRequirement"` //HTML
+	WorkingLocations []struct {
+		CityName   string `json:"cityName"`
+		CityNameVI string `json:"cityNameVI"`
+		Address    string `json:"address"`
+	} `json:"workingLocations"`
+	Skills []struct {
+		SkillName string `json:"skillName"`
+	} `json:"skills"`
+}
+
+// parseSearch decodes a search API response
+func parseSearch(data []byte) (searchResponse, error) {
+	var resp searchResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return searchResponse{}, err
+	}
+	return resp, nil
+}
+
+// toJob converts a search result into a job (salary and posted date from the structured fields)
+func toJob(j searchJob) scraper.Job {
+	var cities []string
+	for _, loc := range j.WorkingLocations {
+		city := strings.TrimSpace(loc.CityNameVI)
+		if city == "" {
+			city = strings.TrimSpace(loc.CityName)
+		}
+		if city != "" && !contains(cities, city) {
+			cities = append(cities, city)
+		}
+	}
+
+	var skills []string
+	for _, skill := range j.Skills {
+		if name := strings.TrimSpace(skill.SkillName); name != "" {
+			skills = append(skills, name)
+		}
+	}
+	techstack := "Golang"
+	if len(skills) > 0 {
+		techstack = strings.Join(skills, ", ")
+	}
+
+	var parts []string
+	for _, html := range []string{j.JobDescription, j.JobRequirement} {
+		if text := strings.TrimSpace(scraper.HTMLToText(html)); text != "" {
+			parts = append(parts, text)
+		}
+	}
+
+	return scraper.Job{
+		Title:       strings.Join(strings.Fields(j.JobTitle), " "),
+		Company:     strings.TrimSpace(j.CompanyName),
+		URL:         jobURL(j),
+		Location:    strings.Join(cities, "; "),
+		Salary:      salaryText(j),
+		Techstack:   techstack,
+		Description: truncate(strings.Join(parts, "\n\n"), maxDescriptionChars),
+		Source:      "VietnamWorks",
+		PostedDate:  isoDate(j.ApprovedOn),
+	}
+}
+
+// jobURL is the job's own link, rebuilt from its alias and id when the API leaves it out
+func jobURL(j searchJob) string {
+	if j.JobURL != "" {
+		return j.JobURL
+	}
+	if j.Alias != "" && j.JobID != 0 {
+		return fmt.Sprintf("https://www.vietnamworks.com/%s-%d-jv", j.Alias, j.JobID)
+	}
+	return ""
+}
+
+// salaryText renders the monthly salary range ("15000000 - 25000000 VND/month"),
+// "Negotiable" when the employer hides it
+func salaryText(j searchJob) string {
+	if !j.IsSalaryVisible {
+		return "Negotiable"
+	}
+	posting := scraper.JobPosting{
+		SalaryMin:      j.SalaryMin,
+		SalaryMax:      j.SalaryMax,
+		SalaryCurrency: strings.ToUpper(j.SalaryCurrency),
+		SalaryUnit:     "MONTH",
+	}
+	if text := posting.SalaryText(); text != "" {
+		return text
+	}
+	if pretty := strings.TrimSpace(j.PrettySalary); pretty != "" {
+		return pretty
+	}
+	return "Negotiable"
+}
+
+// levelAllowed reports whether the job's level label is one of levels;
+// jobs without a label are kept
+func levelAllowed(j searchJob, levels []string) bool {
+	label := utils.NormalizeText(j.JobLevel + " " + j.JobLevelVI)
+	if strings.TrimSpace(label) == "" {
+		return true
+	}
+	for _, level := range levels {
+		words, ok := levelLabels[strings.ToLower(strings.TrimSpace(level))]
+		if !ok {
+			//unknown names are matched as label words ("Mới tốt nghiệp")
+			words = []string{utils.NormalizeText(level)}
+		}
+		for _, word := range words {
+			if word != "" && strings.Contains(label, word) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// locationAllowed reports whether a job location is in one of the configured cities or work modes
+// (see filter.NormalizeLocation); remote jobs and jobs without a location are kept
+func locationAllowed(location string, configured []string) bool {
+	if len(configured) == 0 || strings.TrimSpace(location) == "" {
+		return true
+	}
+	job := filter.NormalizeLocation(location)
+	if job.Mode == filter.ModeRemote || job.Mode == filter.ModeHybrid {
+		return true
+	}
+	for _, entry := range configured {
+		loc := filter.NormalizeLocation(entry)
+		if loc.Mode != filter.ModeUnknown && loc.Mode == job.Mode {
+			return true
+		}
+		for _, city := range loc.Cities {
+			if job.Has(city) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isoDate turns an RFC 3339 timestamp into YYYY-MM-DD in Vietnam time ("N/A" when absent or unreadable)
+func isoDate(s string) string {
+	s = strings.TrimSpace(s)
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"} {
+		//timestamps without an offset are already Vietnam time
+		if t, err := time.ParseInLocation(layout, s, vietnamTime); err == nil {
+			return t.In(vietnamTime).Format("2006-01-02")
+		}
+	}
+	return "N/A"
+}
+
+// vietnamTime is UTC+7 (no daylight saving), so dates match what the site shows
+var vietnamTime = time.FixedZone("ICT", 7*60*60)
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func truncate(s string, max int) string {
+	r := []rune(s)
+	if len(r) <= max {
+		return s
+	}
+	return string(r[:max])
+}
@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "health.json")
+
+	require.NoError(t, WriteFileAtomic(path, []byte(`{"a":1}`)), "parent dirs are created")
+	require.NoError(t, WriteFileAtomic(path, []byte(`{"b":2}`)))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, `{"b":2}`, string(data))
+
+	_, err = os.Stat(path + ".tmp")
+	assert.True(t, os.IsNotExist(err), "temp file is renamed away")
+}
@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeText lowercases and strips Vietnamese diacritics, đ included
+// ("Đăng 3 ngày trước" -> "dang 3 ngay truoc"); fancy-font accents are folded too
+func NormalizeText(str string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	result, _, _ := transform.String(t, str)
+	result = strings.ReplaceAll(result, "đ", "d")
+	result = strings.ReplaceAll(result, "Đ", "D")
+	return strings.ToLower(result)
+}
@@ -0,0 +1,14 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeText(t *testing.T) {
+	assert.Equal(t, "ho chi minh", NormalizeText("Hồ Chí Minh"))
+	assert.Equal(t, "dang 3 ngay truoc", NormalizeText("Đăng 3 ngày trước"))
+	assert.Equal(t, "da nang", NormalizeText("ĐÀ NẴNG"))
+	assert.Equal(t, "golang", NormalizeText("Golang"))
+}
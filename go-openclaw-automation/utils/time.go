@@ -1 +1,211 @@
 package utils
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateConfidence tells how far a parsed posted date can be trusted
+type DateConfidence int
+
+const (
+	DateUnknown  DateConfidence = iota //no date in the text
+	DateApprox                         //coarse phrase: "30+ ngày", "2 tháng trước", "past week"
+	DateRelative                       //relative phrase accurate to the day: "Hôm nay", "3 ngày trước", "5 hours ago"
+	DateExact                          //calendar date: "2026-10-14", "14/10/2026", "14 tháng 10 lúc 09:30"
+)
+
+func (c DateConfidence) String() string {
+	switch c {
+	case DateApprox:
+		return "approx"
+	case DateRelative:
+		return "relative"
+	case DateExact:
+		return "exact"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	isoDateRegex   = regexp.MustCompile(`\b(\d{4})-(\d{2})-(\d{2})`)
+	slashDateRegex = regexp.MustCompile(`\b(\d{1,2})/(\d{1,2})/(\d{4})\b`)
+	//"14 Tháng 10, 2026 lúc 09:30", "14 tháng 10 lúc 09:30" (after accents are stripped)
+	vnDateRegex = regexp.MustCompile(`\b(\d{1,2})\s+thang\s+(\d{1,2})(?:,?\s*(\d{4}))?`)
+	//"October 14, 2026", "Oct 14 at 9:30 AM" / "14 October 2026"
+	enMonthDayRegex = regexp.MustCompile(`\b` + monthPattern + `\.?\s+(\d{1,2})\b(?:,?\s*(\d{4}))?`)
+	enDayMonthRegex = regexp.MustCompile(`\b(\d{1,2})\s+` + monthPattern + `\b\.?(?:,?\s*(\d{4}))?`)
+
+	justNowRegex = regexp.MustCompile(`\b(just now|just posted|vua xong|vua dang|moi dang|hom nay)\b`)
+	//bare "now" / "today" only lead a date field ("Today", "Posted today"), never "Apply now"
+	nowRegex       = regexp.MustCompile(`^((re)?posted |active )?(today|now)\b`)
+	yesterdayRegex = regexp.MustCompile(`\b(yesterday|hom qua)\b`)
+	//"3 ngày trước", "Reposted 1 week ago", "30+ ngày", LinkedIn's short "5h" / "2w" / "1mo"
+	//and its Vietnamese UI's "5 g" (giờ)
+	relativeRegex = regexp.MustCompile(`\b(\d+)(\+?)\s*(giay|phut|gio|ngay|tuan|thang|nam|seconds?|secs?|minutes?|mins?|hours?|hrs?|days?|weeks?|months?|years?|mo|s|m|h|g|d|w|y)\b`)
+	pastRegex     = regexp.MustCompile(`\bpast\s+(hour|day|week|month)\b`)
+)
+
+// feedDateLayouts are the RSS (RFC 822/1123 and common deviations) and Atom (RFC 3339) timestamps
+var feedDateLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	time.RFC3339,
+}
+
+// monthPattern captures an English month name, full or abbreviated
+const monthPattern = `(january|february|march|april|may|june|july|august|september|october|november|december|jan|feb|mar|apr|jun|jul|aug|sept|sep|oct|nov|dec)`
+
+// monthOf maps a monthPattern match to its month
+func monthOf(name string) string {
+	for i, month := range []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"} {
+		if strings.HasPrefix(name, month) {
+			return strconv.Itoa(i + 1)
+		}
+	}
+	return ""
+}
+
+// ParseDate reads a posted date in Vietnamese or English, absolute ("2026-10-14", "14/10/2026",
+// "14 Tháng 10, 2026") or relative to now ("Hôm nay", "3 ngày trước", "Reposted 1 week ago").
+// The date may be surrounded by other text ("Đăng 3 ngày trước"). Dates without a time are
+// midnight in now's location, RSS/Atom timestamps ("Tue, 13 Oct 2026 09:30:00 +0000") too,
+// on the day written in their own offset. Returns DateUnknown (and a zero time) when text has no date.
+func ParseDate(text string, now time.Time) (time.Time, DateConfidence) {
+	for _, layout := range feedDateLayouts {
+		if t, err := time.Parse(layout, strings.TrimSpace(text)); err == nil {
+			return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, now.Location()), DateExact
+		}
+	}
+
+	normalized := normalizeDateText(text)
+	if normalized == "" {
+		return time.Time{}, DateUnknown
+	}
+
+	if t, ok := parseAbsoluteDate(normalized, now); ok {
+		return t, DateExact
+	}
+
+	switch {
+	case yesterdayRegex.MatchString(normalized):
+		return now.AddDate(0, 0, -1), DateRelative
+	case justNowRegex.MatchString(normalized), nowRegex.MatchString(normalized):
+		return now, DateRelative
+	}
+
+	if match := relativeRegex.FindStringSubmatch(normalized); match != nil {
+		if n, err := strconv.Atoi(match[1]); err == nil {
+			t, confidence := relativeDate(now, n, match[3])
+			//"30+ ngày": at least that old
+			if match[2] == "+" {
+				confidence = DateApprox
+			}
+			return t, confidence
+		}
+	}
+
+	if match := pastRegex.FindStringSubmatch(normalized); match != nil {
+		switch match[1] {
+		case "hour":
+			return now.Add(-time.Hour), DateRelative
+		case "day":
+			return now.AddDate(0, 0, -1), DateRelative
+		case "week":
+			return now.AddDate(0, 0, -7), DateApprox
+		default:
+			return now.AddDate(0, 0, -30), DateApprox
+		}
+	}
+	return time.Time{}, DateUnknown
+}
+
+// PostedDate is ParseDate formatted for Job.PostedDate: YYYY-MM-DD, or "Recent" when text has no date
+func PostedDate(text string, now time.Time) string {
+	t, confidence := ParseDate(text, now)
+	if confidence == DateUnknown {
+		return "Recent"
+	}
+	return t.Format("2006-01-02")
+}
+
+// parseAbsoluteDate reads a calendar date; a day and month without a year is the latest
+// such date not after tomorrow (so "14 tháng 10" read in January is last year's)
+func parseAbsoluteDate(normalized string, now time.Time) (time.Time, bool) {
+	if m := isoDateRegex.FindStringSubmatch(normalized); m != nil {
+		return calendarDate(m[1], m[2], m[3], now)
+	}
+	if m := slashDateRegex.FindStringSubmatch(normalized); m != nil {
+		//dd/mm/yyyy, the Vietnamese order
+		return calendarDate(m[3], m[2], m[1], now)
+	}
+	if m := vnDateRegex.FindStringSubmatch(normalized); m != nil {
+		return calendarDate(m[3], m[2], m[1], now)
+	}
+	if m := enMonthDayRegex.FindStringSubmatch(normalized); m != nil {
+		return calendarDate(m[3], monthOf(m[1]), m[2], now)
+	}
+	if m := enDayMonthRegex.FindStringSubmatch(normalized); m != nil {
+		return calendarDate(m[3], monthOf(m[2]), m[1], now)
+	}
+	return time.Time{}, false
+}
+
+// calendarDate validates year/month/day (year may be empty) and builds the date in now's location
+func calendarDate(year, month, day string, now time.Time) (time.Time, bool) {
+	m, errM := strconv.Atoi(month)
+	d, errD := strconv.Atoi(day)
+	if errM != nil || errD != nil || m < 1 || m > 12 || d < 1 || d > 31 {
+		return time.Time{}, false
+	}
+
+	y := now.Year()
+	if year != "" {
+		var err error
+		if y, err = strconv.Atoi(year); err != nil {
+			return time.Time{}, false
+		}
+	}
+
+	t := time.Date(y, time.Month(m), d, 0, 0, 0, 0, now.Location())
+	//time.Date normalizes 31/02 to March, reject it instead
+	if t.Day() != d {
+		return time.Time{}, false
+	}
+	if year == "" && t.After(now.AddDate(0, 0, 1)) {
+		t = t.AddDate(-1, 0, 0)
+	}
+	return t, true
+}
+
+// relativeDate goes back n units from now; units finer than a week are accurate to the day
+func relativeDate(now time.Time, n int, unit string) (time.Time, DateConfidence) {
+	switch unit {
+	case "s", "giay", "sec", "secs", "second", "seconds":
+		return now.Add(-time.Duration(n) * time.Second), DateRelative
+	case "m", "phut", "min", "mins", "minute", "minutes":
+		return now.Add(-time.Duration(n) * time.Minute), DateRelative
+	case "h", "g", "gio", "hr", "hrs", "hour", "hours":
+		return now.Add(-time.Duration(n) * time.Hour), DateRelative
+	case "d", "ngay", "day", "days":
+		return now.AddDate(0, 0, -n), DateRelative
+	case "w", "tuan", "week", "weeks":
+		return now.AddDate(0, 0, -7*n), DateApprox
+	case "mo", "thang", "month", "months":
+		return now.AddDate(0, -n, 0), DateApprox
+	default:
+		return now.AddDate(-n, 0, 0), DateApprox
+	}
+}
+
+// normalizeDateText lowercases, strips Vietnamese diacritics and collapses whitespace
+// ("Đăng 3 ngày trước" -> "dang 3 ngay truoc")
+func normalizeDateText(text string) string {
+	return strings.Join(strings.Fields(NormalizeText(text)), " ")
+}
@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDate(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 30, 0, 0, time.Local)
+	day := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+	}
+
+	tests := []struct {
+		text       string
+		want       time.Time
+		confidence DateConfidence
+	}{
+		//calendar dates
+		{"2026-10-14", day(2026, 10, 14), DateExact},
+		{"2026-10-14T08:00:00+07:00", day(2026, 10, 14), DateExact},
+		{"Wed, 14 Oct 2026 23:30:00 -0700", day(2026, 10, 14), DateExact},
+		{"14/10/2026", day(2026, 10, 14), DateExact},
+		{"Ngày đăng: 02/09/2026 10:15", day(2026, 9, 2), DateExact},
+		{"14 Tháng 10, 2026 lúc 09:30", day(2026, 10, 14), DateExact},
+		{"14 tháng 10 lúc 09:30", day(2026, 10, 14), DateExact},
+		{"20 tháng 12 lúc 18:00", day(2025, 12, 20), DateExact},
+		{"October 14, 2026", day(2026, 10, 14), DateExact},
+		{"Oct 3 at 9:30 AM", day(2026, 10, 3), DateExact},
+		{"3 September 2026", day(2026, 9, 3), DateExact},
+
+		//today and yesterday
+		{"Hôm nay", now, DateRelative},
+		{"Đăng hôm nay", now, DateRelative},
+		{"Vừa đăng", now, DateRelative},
+		{"Just posted", now, DateRelative},
+		{"vừa xong", now, DateRelative},
+		{"Today", now, DateRelative},
+		{"Posted today", now, DateRelative},
+		{"now", now, DateRelative},
+		{"Hôm qua", now.AddDate(0, 0, -1), DateRelative},
+		{"Yesterday", now.AddDate(0, 0, -1), DateRelative},
+
+		//relative phrases
+		{"3 ngày trước", now.AddDate(0, 0, -3), DateRelative},
+		{"Đăng 5 giờ trước", now.Add(-5 * time.Hour), DateRelative},
+		{"45 phút trước", now.Add(-45 * time.Minute), DateRelative},
+		{"5 days ago", now.AddDate(0, 0, -5), DateRelative},
+		{"Posted 2 days ago", now.AddDate(0, 0, -2), DateRelative},
+		{"EmployerActive 6 days ago", now.AddDate(0, 0, -6), DateRelative},
+		{"3h • Edited •", now.Add(-3 * time.Hour), DateRelative},
+		{"2d", now.AddDate(0, 0, -2), DateRelative},
+		{"5 g • Đã chỉnh sửa", now.Add(-5 * time.Hour), DateRelative},
+		{"past day", now.AddDate(0, 0, -1), DateRelative},
+
+		//coarse phrases
+		{"2 tuần trước", now.AddDate(0, 0, -14), DateApprox},
+		{"Reposted 1 week ago", now.AddDate(0, 0, -7), DateApprox},
+		{"Hoạt động 2 tuần trước", now.AddDate(0, 0, -14), DateApprox},
+		{"Đã đăng 30+ ngày trước", now.AddDate(0, 0, -30), DateApprox},
+		{"PostedPosted 1 month ago", now.AddDate(0, -1, 0), DateApprox},
+		{"3 tháng trước", now.AddDate(0, -3, 0), DateApprox},
+		{"2mo •", now.AddDate(0, -2, 0), DateApprox},
+		{"1 năm trước", now.AddDate(-1, 0, 0), DateApprox},
+		{"past week", now.AddDate(0, 0, -7), DateApprox},
+
+		//no date
+		{"", time.Time{}, DateUnknown},
+		{"  ", time.Time{}, DateUnknown},
+		{"Recent", time.Time{}, DateUnknown},
+		{"N/A", time.Time{}, DateUnknown},
+		{"Tuyển gấp", time.Time{}, DateUnknown},
+		{"Promoted", time.Time{}, DateUnknown},
+		{"25 applicants", time.Time{}, DateUnknown},
+		{"31/02/2026", time.Time{}, DateUnknown},
+		{"Apply now", time.Time{}, DateUnknown},
+		{"Hiring now - 2 positions", time.Time{}, DateUnknown},
+		{"Available today for interviews", time.Time{}, DateUnknown},
+	}
+	for _, tt := range tests {
+		got, confidence := ParseDate(tt.text, now)
+		assert.Equal(t, tt.confidence, confidence, "%q confidence", tt.text)
+		assert.True(t, tt.want.Equal(got), "%q: got %v, want %v", tt.text, got, tt.want)
+	}
+}
+
+func TestPostedDate(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 30, 0, 0, time.Local)
+
+	assert.Equal(t, "2026-10-13", PostedDate("Đăng 3 ngày trước", now))
+	assert.Equal(t, "2026-10-16", PostedDate("Đăng 5 giờ trước", now))
+	assert.Equal(t, "2026-09-16", PostedDate("30+ ngày", now))
+	assert.Equal(t, "2026-10-13", PostedDate("Tue, 13 Oct 2026 23:30:00 +0000", now), "RSS pubDate keeps its offset")
+	assert.Equal(t, "2026-10-05", PostedDate("Mon, 5 Oct 2026 08:00:00 GMT", now))
+	assert.Equal(t, "2026-10-14", PostedDate("2026-10-14T10:00:00+07:00", now), "Atom timestamp")
+	assert.Equal(t, "Recent", PostedDate("Tuyển gấp", now))
+	assert.Equal(t, "Recent", PostedDate("", now))
+}